@@ -0,0 +1,201 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"log"
+	"net"
+	"net/netip"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// 访问控制列表, 文件每行一条: "allow <CIDR|IP>" 或 "deny <CIDR|IP>", # 开头为注释
+// deny 优先; 存在 allow 条目时只接受命中 allow 的地址
+type AccessList struct {
+	allow []netip.Prefix
+	deny  []netip.Prefix
+}
+
+// 从文件加载访问控制列表
+func LoadAccessList(path string) (*AccessList, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	list := &AccessList{}
+	scanner := bufio.NewScanner(f)
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("%s:%d: expected \"allow|deny <CIDR>\"", path, lineNo)
+		}
+		prefix, err := parsePrefix(fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %v", path, lineNo, err)
+		}
+		switch fields[0] {
+		case "allow":
+			list.allow = append(list.allow, prefix)
+		case "deny":
+			list.deny = append(list.deny, prefix)
+		default:
+			return nil, fmt.Errorf("%s:%d: unknown action %q", path, lineNo, fields[0])
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// 解析 CIDR, 单个 IP 视为只包含它自己的网段
+func parsePrefix(s string) (netip.Prefix, error) {
+	if strings.Contains(s, "/") {
+		prefix, err := netip.ParsePrefix(s)
+		return prefix.Masked(), err
+	}
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	addr = addr.Unmap()
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
+
+func containsAddr(prefixes []netip.Prefix, addr netip.Addr) bool {
+	for _, prefix := range prefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// 地址是否允许连接
+func (l *AccessList) Allowed(addr netip.Addr) bool {
+	if containsAddr(l.deny, addr) {
+		return false
+	}
+	return len(l.allow) == 0 || containsAddr(l.allow, addr)
+}
+
+// 单个 IP 的连接状态
+type ipAdmission struct {
+	conns    int
+	bucket   *TokenBucket
+	lastSeen time.Time
+}
+
+// 连接准入控制: 全局连接数、单 IP 连接数、单 IP 建连速率和访问控制列表
+type AdmissionControl struct {
+	mu        sync.Mutex
+	total     int
+	ips       map[netip.Addr]*ipAdmission
+	lastSweep time.Time
+
+	accessList atomic.Pointer[AccessList] // 为空表示不限制
+}
+
+func NewAdmissionControl() *AdmissionControl {
+	return &AdmissionControl{
+		ips:       make(map[netip.Addr]*ipAdmission),
+		lastSweep: time.Now(),
+	}
+}
+
+// 全局准入控制实例
+var Admission = NewAdmissionControl()
+
+// 重新加载 Config.AccessListFile, 失败时保留原来的列表
+func (a *AdmissionControl) ReloadAccessList() error {
+	if Config.AccessListFile == "" {
+		return nil
+	}
+	list, err := LoadAccessList(Config.AccessListFile)
+	if err != nil {
+		return err
+	}
+	a.accessList.Store(list)
+	log.Printf("Access list loaded from %s: %d allow, %d deny", Config.AccessListFile, len(list.allow), len(list.deny))
+	return nil
+}
+
+// 检查是否接受来自 addr 的连接, 接受时返回连接关闭后必须调用的 release, 拒绝时返回原因
+func (a *AdmissionControl) Admit(addr netip.Addr) (release func(), reason string) {
+	if list := a.accessList.Load(); list != nil && !list.Allowed(addr) {
+		return nil, "denied by access list"
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	now := time.Now()
+	a.sweep(now)
+
+	if Config.MaxConns > 0 && a.total >= Config.MaxConns {
+		return nil, "too many connections"
+	}
+	state, ok := a.ips[addr]
+	if !ok {
+		state = &ipAdmission{}
+		if Config.ConnRatePerIP > 0 {
+			state.bucket = NewTokenBucket(Config.ConnRatePerIP, Config.ConnBurstPerIP)
+		}
+		a.ips[addr] = state
+	}
+	state.lastSeen = now
+	if Config.MaxConnsPerIP > 0 && state.conns >= Config.MaxConnsPerIP {
+		return nil, "too many connections from this address"
+	}
+	if state.bucket != nil && !state.bucket.AllowAt(now) {
+		return nil, "connecting too fast"
+	}
+
+	a.total++
+	state.conns++
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			a.mu.Lock()
+			defer a.mu.Unlock()
+			a.total--
+			state.conns--
+			state.lastSeen = time.Now()
+		})
+	}, ""
+}
+
+// 每分钟清理一次没有连接且长时间未出现的 IP, 此时它们的令牌桶早已补满
+func (a *AdmissionControl) sweep(now time.Time) {
+	if now.Sub(a.lastSweep) < time.Minute {
+		return
+	}
+	a.lastSweep = now
+	for addr, state := range a.ips {
+		if state.conns == 0 && now.Sub(state.lastSeen) > time.Minute {
+			delete(a.ips, addr)
+		}
+	}
+}
+
+// 连接的对端 IP
+func remoteAddr(conn net.Conn) netip.Addr {
+	if addr, ok := conn.RemoteAddr().(*net.TCPAddr); ok {
+		return addr.AddrPort().Addr().Unmap()
+	}
+	addrPort, err := netip.ParseAddrPort(conn.RemoteAddr().String())
+	if err != nil {
+		return netip.Addr{}
+	}
+	return addrPort.Addr().Unmap()
+}
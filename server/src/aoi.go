@@ -0,0 +1,217 @@
+package main
+
+import (
+	"math"
+
+	pb "server/src/proto"
+)
+
+// 九宫格视野管理, 格子边长等于视野半径, 查询时只需检查周围 3x3 个格子
+// 坐标取 Position 的 x/y 平面, z 不参与视野计算
+type AOIGrid struct {
+	cellSize float32
+	cells    map[aoiCell]map[string]*pb.Position
+	objects  map[string]aoiCell // 对象当前所在的格子
+}
+
+type aoiCell struct {
+	X, Y int32
+}
+
+func NewAOIGrid(cellSize float32) *AOIGrid {
+	return &AOIGrid{
+		cellSize: cellSize,
+		cells:    make(map[aoiCell]map[string]*pb.Position),
+		objects:  make(map[string]aoiCell),
+	}
+}
+
+func (g *AOIGrid) cellOf(pos *pb.Position) aoiCell {
+	return aoiCell{
+		X: int32(math.Floor(float64(pos.GetX() / g.cellSize))),
+		Y: int32(math.Floor(float64(pos.GetY() / g.cellSize))),
+	}
+}
+
+// 放入或移动一个对象, 只有跨格子时才调整格子
+func (g *AOIGrid) Update(id string, pos *pb.Position) {
+	cell := g.cellOf(pos)
+	if old, ok := g.objects[id]; ok && old != cell {
+		g.removeFromCell(old, id)
+	}
+	objs, ok := g.cells[cell]
+	if !ok {
+		objs = make(map[string]*pb.Position)
+		g.cells[cell] = objs
+	}
+	objs[id] = pos
+	g.objects[id] = cell
+}
+
+// 移除一个对象
+func (g *AOIGrid) Remove(id string) {
+	if cell, ok := g.objects[id]; ok {
+		g.removeFromCell(cell, id)
+		delete(g.objects, id)
+	}
+}
+
+func (g *AOIGrid) removeFromCell(cell aoiCell, id string) {
+	objs := g.cells[cell]
+	delete(objs, id)
+	if len(objs) == 0 {
+		delete(g.cells, cell)
+	}
+}
+
+// 查询 pos 周围 radius 内的对象, radius 不应超过格子边长
+func (g *AOIGrid) QueryRadius(pos *pb.Position, radius float32) map[string]struct{} {
+	result := make(map[string]struct{})
+	center := g.cellOf(pos)
+	for dx := int32(-1); dx <= 1; dx++ {
+		for dy := int32(-1); dy <= 1; dy++ {
+			for id, other := range g.cells[aoiCell{X: center.X + dx, Y: center.Y + dy}] {
+				x := other.GetX() - pos.GetX()
+				y := other.GetY() - pos.GetY()
+				if x*x+y*y <= radius*radius {
+					result[id] = struct{}{}
+				}
+			}
+		}
+	}
+	return result
+}
+
+// 玩家 viewerId 是否能看到 targetId, 未开启视野过滤时总是可见
+func (r *Room) CanSee(viewerId, targetId string) bool {
+	if r.aoi == nil || viewerId == targetId {
+		return true
+	}
+	_, ok := r.views[viewerId][targetId]
+	return ok
+}
+
+// 同步所有玩家在格子中的位置并重新计算可见集合, 用于多名玩家可能同时移动的情况
+func (r *Room) updateViews() {
+	r.Mutex.Lock()
+	defer r.Mutex.Unlock()
+
+	for id := range r.aoi.objects {
+		if _, ok := r.Players[id]; !ok {
+			r.aoi.Remove(id)
+			delete(r.views, id)
+		}
+	}
+	for id, player := range r.Players {
+		r.aoi.Update(id, player.Position)
+	}
+
+	// 可见关系是对称的, 每个玩家只需处理自己看到的变化
+	for id, player := range r.Players {
+		visible := r.aoi.QueryRadius(player.Position, r.AoiRadius)
+		delete(visible, id)
+		old := r.views[id]
+		r.views[id] = visible
+
+		var entered []*pb.Player
+		for otherId := range visible {
+			if _, ok := old[otherId]; !ok {
+				entered = append(entered, r.Players[otherId].ToProto())
+			}
+		}
+		var left []string
+		for otherId := range old {
+			if _, ok := visible[otherId]; !ok {
+				left = append(left, otherId)
+			}
+		}
+		sendAoiChanges(player, entered, left)
+	}
+}
+
+// 只有 moverId 移动时, 调整它所在的格子并更新与它相关的可见关系
+func (r *Room) updateViewsFor(moverId string) {
+	r.Mutex.Lock()
+	defer r.Mutex.Unlock()
+
+	mover, ok := r.Players[moverId]
+	if !ok {
+		return
+	}
+	r.aoi.Update(moverId, mover.Position)
+
+	visible := r.aoi.QueryRadius(mover.Position, r.AoiRadius)
+	delete(visible, moverId)
+	old := r.views[moverId]
+	r.views[moverId] = visible
+
+	var entered []*pb.Player
+	var left []string
+	for otherId := range visible {
+		if _, ok := old[otherId]; ok {
+			continue
+		}
+		other := r.Players[otherId]
+		entered = append(entered, other.ToProto())
+		if r.views[otherId] == nil {
+			r.views[otherId] = make(map[string]struct{})
+		}
+		r.views[otherId][moverId] = struct{}{}
+		sendAoiChanges(other, []*pb.Player{mover.ToProto()}, nil)
+	}
+	for otherId := range old {
+		if _, ok := visible[otherId]; ok {
+			continue
+		}
+		left = append(left, otherId)
+		delete(r.views[otherId], moverId)
+		if other, ok := r.Players[otherId]; ok {
+			sendAoiChanges(other, nil, []string{moverId})
+		}
+	}
+	sendAoiChanges(mover, entered, left)
+}
+
+// 发送进入/离开视野通知
+func sendAoiChanges(player *Player, entered []*pb.Player, left []string) {
+	if len(entered) > 0 {
+		player.SendMessage(&pb.Message{
+			Id:          pb.MessageId_AOI_ENTER_NOTIFICATION,
+			MsgSerialNo: -1,
+			ClientId:    "",
+			Data:        mustMarshal(&pb.AoiEnterNotification{Players: entered}),
+		})
+	}
+	if len(left) > 0 {
+		player.SendMessage(&pb.Message{
+			Id:          pb.MessageId_AOI_LEAVE_NOTIFICATION,
+			MsgSerialNo: -1,
+			ClientId:    "",
+			Data:        mustMarshal(&pb.AoiLeaveNotification{PlayerIds: left}),
+		})
+	}
+}
+
+// 只包含 viewerId 可见玩家（及其自身）的房间信息
+func (r *Room) FillRoomMsgFor(viewerId string) *pb.Room {
+	room := r.FillRoomMsg()
+	if r.aoi == nil {
+		return room
+	}
+	visible := room.Players[:0]
+	for _, player := range room.Players {
+		if r.CanSee(viewerId, player.Id) {
+			visible = append(visible, player)
+		}
+	}
+	room.Players = visible
+
+	entities := room.Entities[:0]
+	for _, entity := range room.Entities {
+		if r.CanSeeEntity(viewerId, r.Entities[entity.Id]) {
+			entities = append(entities, entity)
+		}
+	}
+	room.Entities = entities
+	return room
+}
@@ -0,0 +1,194 @@
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	pb "server/src/proto"
+)
+
+// 发送: 改造前逐条写出与合并写出的对比
+func BenchmarkSend(b *testing.B) {
+	for _, batch := range []int{1, 16} {
+		b.Run(fmt.Sprintf("legacy/batch=%d", batch), benchLegacySend(batch))
+		b.Run(fmt.Sprintf("coalesced/batch=%d", batch), benchCoalescedSend(batch))
+	}
+}
+
+// 广播给 100 名玩家: 每个玩家各自编码与只编码一次的对比
+func BenchmarkBroadcast(b *testing.B) {
+	b.Run("per-player/players=100", benchBroadcast(100, false))
+	b.Run("marshal-once/players=100", benchBroadcast(100, true))
+}
+
+// 读取: 每次分配临时缓冲区与复用缓冲区的对比
+func BenchmarkRead(b *testing.B) {
+	b.Run("legacy", benchRead(legacyReadFrames))
+	b.Run("pooled", benchRead(readFrames))
+}
+
+// 统计 Write 调用次数, 代替真实连接
+type countingWriter struct {
+	writes int
+	bytes  int
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	w.writes++
+	w.bytes += len(p)
+	return len(p), nil
+}
+
+func benchMessage() *pb.Message {
+	return &pb.Message{
+		Id:          pb.MessageId_ROOM_STATE_NOTIFICATION,
+		MsgSerialNo: -1,
+		Data: mustMarshal(&pb.RoomStateNotification{Room: &pb.Room{
+			Id:   1,
+			Name: "bench",
+			Players: []*pb.Player{
+				{Id: "player-1", Name: "a", Position: &pb.Position{X: 1, Y: 2, Z: 3}},
+				{Id: "player-2", Name: "b", Position: &pb.Position{X: 4, Y: 5, Z: 6}},
+			},
+		}}),
+	}
+}
+
+func reportPerMessage(b *testing.B, w *countingWriter, msgs int) {
+	b.ReportMetric(float64(w.writes)/float64(msgs), "writes/msg")
+	b.ReportMetric(float64(b.Elapsed().Nanoseconds())/float64(msgs), "ns/msg")
+}
+
+// 改造前的发送方式: 每条消息单独分配长度前缀并写一次
+func legacyWrite(w io.Writer, msg *pb.Message) error {
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	length := make([]byte, 4)
+	binary.LittleEndian.PutUint32(length, uint32(len(data)))
+	packet := append(length, data...)
+	_, err = w.Write(packet)
+	return err
+}
+
+func benchLegacySend(batch int) func(b *testing.B) {
+	return func(b *testing.B) {
+		msg := benchMessage()
+		w := &countingWriter{}
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			for j := 0; j < batch; j++ {
+				legacyWrite(w, msg)
+			}
+		}
+		reportPerMessage(b, w, b.N*batch)
+	}
+}
+
+func benchCoalescedSend(batch int) func(b *testing.B) {
+	return func(b *testing.B) {
+		msgs := make([]outboundItem, batch)
+		for i := range msgs {
+			msgs[i] = outboundItem{msg: benchMessage()}
+		}
+		w := &countingWriter{}
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			writeFrames(w, msgs)
+		}
+		reportPerMessage(b, w, b.N*batch)
+	}
+}
+
+// 一次广播的完整开销: 入队到每个玩家的发送队列, 再由各自的发送协程取出并写出
+// marshalOnce 为 false 时每个玩家各自编码同一条消息(改造前的方式)
+func benchBroadcast(players int, marshalOnce bool) func(b *testing.B) {
+	return func(b *testing.B) {
+		queues := make([]*SendQueue, players)
+		for i := range queues {
+			queues[i] = NewSendQueue(1000, 800)
+		}
+		msg := benchMessage()
+		w := &countingWriter{}
+		var pending []outboundItem
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			item := outboundItem{msg: msg}
+			if marshalOnce {
+				item = outboundItem{frame: mustEncodeFrame(msg)}
+			}
+			for _, q := range queues {
+				q.Push(item, PriorityState)
+			}
+			for _, q := range queues {
+				pending = q.PopAll(pending[:0])
+				writeFrames(w, pending)
+			}
+		}
+		b.ReportMetric(float64(b.Elapsed().Nanoseconds())/float64(b.N*players), "ns/recipient")
+	}
+}
+
+// 改造前的读取方式: 每次 Read 分配新的临时缓冲区
+func legacyReadFrames(r io.Reader, handle func(msg *pb.Message)) error {
+	buffer := make([]byte, 0, 4096)
+	for {
+		tempBuf := make([]byte, 1024)
+		n, err := r.Read(tempBuf)
+		if err != nil {
+			return err
+		}
+		buffer = append(buffer, tempBuf[:n]...)
+		for len(buffer) >= 4 {
+			length := int(binary.LittleEndian.Uint32(buffer[:4]))
+			if len(buffer) < 4+length {
+				break
+			}
+			messageBuf := buffer[4 : 4+length]
+			buffer = buffer[4+length:]
+			var parsedMsg pb.Message
+			if err := proto.Unmarshal(messageBuf, &parsedMsg); err != nil {
+				continue
+			}
+			handle(&parsedMsg)
+		}
+	}
+}
+
+// 每次最多返回 chunk 字节, 模拟 TCP 分段到达
+type chunkReader struct {
+	data  []byte
+	chunk int
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	if len(r.data) == 0 {
+		return 0, io.EOF
+	}
+	n := min(len(p), r.chunk, len(r.data))
+	copy(p, r.data[:n])
+	r.data = r.data[n:]
+	return n, nil
+}
+
+func benchRead(read func(r io.Reader, handle func(msg *pb.Message)) error) func(b *testing.B) {
+	return func(b *testing.B) {
+		frame, _ := appendFrame(nil, benchMessage())
+		data := bytes.Repeat(frame, b.N)
+		received := 0
+		b.ReportAllocs()
+		b.ResetTimer()
+		read(&chunkReader{data: data, chunk: 1500}, func(msg *pb.Message) { received++ })
+		if received != b.N {
+			b.Fatalf("received %d messages, want %d", received, b.N)
+		}
+	}
+}
@@ -0,0 +1,268 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"math"
+	"net/netip"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "server/src/proto"
+)
+
+// 服务器配置, 启动时由命令行参数覆盖默认值
+type ServerConfig struct {
+	ListenAddr     string
+	MapDir         string        // 静态地图目录
+	RequestTimeout time.Duration // 单个请求的处理超时
+	MaxFrameSize   int           // 单个消息帧的最大长度(不含长度前缀), 超过时断开连接
+
+	// 连接准入
+	MaxConns       int     // 全局最大连接数, 0 表示不限制
+	MaxConnsPerIP  int     // 单个 IP 最大连接数, 0 表示不限制
+	ConnRatePerIP  float64 // 单个 IP 每秒允许新建的连接数, 0 表示不限制
+	ConnBurstPerIP int     // 单个 IP 建连速率的突发上限
+	AccessListFile string  // allow/deny CIDR 列表文件, 收到 SIGHUP 时重新加载
+
+	// PROXY 协议, 部署在 HAProxy/NLB 之后时用于获取客户端真实地址
+	ProxyProtocol      bool          // 解析连接开头的 PROXY v1/v2 头部
+	ProxyTrusted       PrefixList    // 只解析来自这些地址的头部, 启用 PROXY 协议时必须设置
+	ProxyHeaderTimeout time.Duration // 等待头部的时限
+
+	// 停服
+	ShutdownTimeout time.Duration // 关闭房间和发完消息的总时限
+	ReconnectAddr   string        // 停服通知中建议客户端重连的地址
+	ReconnectDelay  time.Duration // 停服通知中建议客户端等待的重连时间
+
+	// 发送队列
+	SendQueueSize      int           // 可丢弃消息的队列上限
+	SendBacklog        int           // 队列长度达到该值视为积压
+	SendBacklogTimeout time.Duration // 持续积压超过该时间断开玩家, 0 表示不断开
+
+	// 移动校验
+	MaxMoveSpeed      float64     // 最大移动速度(单位/秒), 0 表示不校验速度
+	MoveTolerance     float64     // 速度校验额外允许的距离, 用于容忍网络抖动
+	WorldBounds       WorldBounds // 世界边界, 未设置时不校验
+	MaxMoveViolations int         // 违规次数达到后踢出玩家, 0 表示不踢出
+
+	// 消息中间件
+	RequireLogin bool    // 登录前只允许发送 LOGIN_REQUEST
+	MsgRate      float64 // 每个玩家每秒允许的消息数, 0 表示不限制
+	MsgBurst     int     // 消息限流的突发上限
+
+	MsgRateLimits     MessageRateLimits // 单个消息的限流, 与全局限流同时生效
+	MaxRateViolations int               // 一分钟内超限次数达到后断开玩家, 0 表示不断开
+
+	// 处理器 panic 后的处理, 默认只记录日志并回复内部错误
+	PanicKickPlayer bool // 断开出错的玩家
+	PanicCloseRoom  bool // 关闭出错的房间
+}
+
+// 世界边界, 格式: minX,minY,minZ,maxX,maxY,maxZ
+type WorldBounds struct {
+	Enabled bool
+	Min     [3]float32
+	Max     [3]float32
+}
+
+func (b *WorldBounds) String() string {
+	if !b.Enabled {
+		return ""
+	}
+	return fmt.Sprintf("%g,%g,%g,%g,%g,%g", b.Min[0], b.Min[1], b.Min[2], b.Max[0], b.Max[1], b.Max[2])
+}
+
+func (b *WorldBounds) Set(value string) error {
+	parts := strings.Split(value, ",")
+	if len(parts) != 6 {
+		return fmt.Errorf("world bounds need 6 values, got %d", len(parts))
+	}
+	var v [6]float32
+	for i, part := range parts {
+		f, err := strconv.ParseFloat(strings.TrimSpace(part), 32)
+		if err != nil {
+			return fmt.Errorf("invalid world bounds value %q: %v", part, err)
+		}
+		v[i] = float32(f)
+	}
+	for i := 0; i < 3; i++ {
+		if v[i] > v[i+3] {
+			return fmt.Errorf("world bounds min %g greater than max %g", v[i], v[i+3])
+		}
+	}
+	b.Min = [3]float32{v[0], v[1], v[2]}
+	b.Max = [3]float32{v[3], v[4], v[5]}
+	b.Enabled = true
+	return nil
+}
+
+// 逗号分隔的 CIDR 列表, 单个 IP 视为只包含它自己的网段
+type PrefixList []netip.Prefix
+
+func (l *PrefixList) String() string {
+	if l == nil {
+		return ""
+	}
+	parts := make([]string, len(*l))
+	for i, prefix := range *l {
+		parts[i] = prefix.String()
+	}
+	return strings.Join(parts, ",")
+}
+
+func (l *PrefixList) Set(value string) error {
+	for _, item := range strings.Split(value, ",") {
+		prefix, err := parsePrefix(strings.TrimSpace(item))
+		if err != nil {
+			return err
+		}
+		*l = append(*l, prefix)
+	}
+	return nil
+}
+
+// 单条消息的限流参数
+type RateLimitSpec struct {
+	Rate  float64 // 每秒允许的消息数
+	Burst int
+}
+
+// 按消息 id 的限流配置, 格式: MESSAGE_ID=count/duration[:burst],...
+// duration 可以省略数字, 例如 30/s, 2/s, 1/10s, 100/m:20; burst 默认为 count
+type MessageRateLimits map[pb.MessageId]RateLimitSpec
+
+func (l MessageRateLimits) String() string {
+	var parts []string
+	for msgId, spec := range l {
+		parts = append(parts, fmt.Sprintf("%s=%g/s:%d", msgId, spec.Rate, spec.Burst))
+	}
+	sort.Strings(parts)
+	return strings.Join(parts, ",")
+}
+
+func (l MessageRateLimits) Set(value string) error {
+	for _, item := range strings.Split(value, ",") {
+		name, spec, ok := strings.Cut(strings.TrimSpace(item), "=")
+		if !ok {
+			return fmt.Errorf("invalid rate limit %q, expected MESSAGE_ID=count/duration", item)
+		}
+		id, ok := pb.MessageId_value[name]
+		if !ok {
+			n, err := strconv.Atoi(name)
+			if err != nil {
+				return fmt.Errorf("unknown message id %q", name)
+			}
+			id = int32(n)
+		}
+		limit, err := parseRateLimit(spec)
+		if err != nil {
+			return fmt.Errorf("invalid rate limit for %s: %v", name, err)
+		}
+		l[pb.MessageId(id)] = limit
+	}
+	return nil
+}
+
+// 解析 count/duration[:burst]
+func parseRateLimit(spec string) (RateLimitSpec, error) {
+	spec, burstStr, hasBurst := strings.Cut(spec, ":")
+	countStr, durStr, ok := strings.Cut(spec, "/")
+	if !ok {
+		return RateLimitSpec{}, fmt.Errorf("expected count/duration, got %q", spec)
+	}
+	count, err := strconv.ParseFloat(countStr, 64)
+	if err != nil || count <= 0 {
+		return RateLimitSpec{}, fmt.Errorf("invalid count %q", countStr)
+	}
+	if durStr != "" && (durStr[0] < '0' || durStr[0] > '9') {
+		durStr = "1" + durStr
+	}
+	dur, err := time.ParseDuration(durStr)
+	if err != nil || dur <= 0 {
+		return RateLimitSpec{}, fmt.Errorf("invalid duration %q", durStr)
+	}
+
+	limit := RateLimitSpec{
+		Rate:  count / dur.Seconds(),
+		Burst: int(math.Ceil(count)),
+	}
+	if hasBurst {
+		if limit.Burst, err = strconv.Atoi(burstStr); err != nil || limit.Burst <= 0 {
+			return RateLimitSpec{}, fmt.Errorf("invalid burst %q", burstStr)
+		}
+	}
+	return limit, nil
+}
+
+// 默认配置
+func DefaultConfig() ServerConfig {
+	return ServerConfig{
+		ListenAddr:     ":12345",
+		MapDir:         "maps",
+		RequestTimeout: 5 * time.Second,
+		MaxFrameSize:   64 * 1024,
+
+		ShutdownTimeout: 10 * time.Second,
+
+		ConnBurstPerIP: 10,
+
+		ProxyHeaderTimeout: 5 * time.Second,
+
+		SendQueueSize:      1000,
+		SendBacklog:        800,
+		SendBacklogTimeout: 10 * time.Second,
+		MoveTolerance:      1,
+		MsgBurst:           50,
+		MsgRateLimits:      MessageRateLimits{},
+		MaxRateViolations:  50,
+	}
+}
+
+// 检查参数之间的约束
+func (c *ServerConfig) Validate() error {
+	// 不限制来源时任何客户端都能伪造自己的地址, 绕过按 IP 的准入控制
+	if c.ProxyProtocol && len(c.ProxyTrusted) == 0 {
+		return errors.New("-proxy-protocol requires -proxy-trusted")
+	}
+	return nil
+}
+
+// 注册命令行参数
+func (c *ServerConfig) RegisterFlags(fs *flag.FlagSet) {
+	fs.StringVar(&c.ListenAddr, "addr", c.ListenAddr, "listen address")
+	fs.StringVar(&c.MapDir, "map-dir", c.MapDir, "directory containing <mapName>.json static maps")
+	fs.DurationVar(&c.RequestTimeout, "request-timeout", c.RequestTimeout, "deadline for handling a single request")
+	fs.IntVar(&c.MaxFrameSize, "max-frame-size", c.MaxFrameSize, "max size in bytes of a single incoming message frame")
+	fs.IntVar(&c.MaxConns, "max-conns", c.MaxConns, "max concurrent connections, 0 for unlimited")
+	fs.IntVar(&c.MaxConnsPerIP, "max-conns-per-ip", c.MaxConnsPerIP, "max concurrent connections per IP, 0 for unlimited")
+	fs.Float64Var(&c.ConnRatePerIP, "conn-rate-per-ip", c.ConnRatePerIP, "new connections per second allowed per IP, 0 for unlimited")
+	fs.IntVar(&c.ConnBurstPerIP, "conn-burst-per-ip", c.ConnBurstPerIP, "burst size for per-IP connection rate limiting")
+	fs.StringVar(&c.AccessListFile, "access-list", c.AccessListFile, "file of \"allow|deny <CIDR>\" lines, reloaded on SIGHUP")
+	fs.BoolVar(&c.ProxyProtocol, "proxy-protocol", c.ProxyProtocol, "expect a PROXY protocol v1/v2 header from trusted sources and use the client address it carries")
+	fs.Var(&c.ProxyTrusted, "proxy-trusted", "comma separated CIDRs of proxies allowed to send PROXY headers, required with -proxy-protocol")
+	fs.DurationVar(&c.ProxyHeaderTimeout, "proxy-header-timeout", c.ProxyHeaderTimeout, "time allowed for a proxy to send the PROXY header")
+	fs.DurationVar(&c.ShutdownTimeout, "shutdown-timeout", c.ShutdownTimeout, "time allowed for closing rooms and flushing messages on shutdown")
+	fs.StringVar(&c.ReconnectAddr, "reconnect-addr", c.ReconnectAddr, "server address suggested to clients in the shutdown notification")
+	fs.DurationVar(&c.ReconnectDelay, "reconnect-delay", c.ReconnectDelay, "reconnect delay suggested to clients in the shutdown notification")
+	fs.IntVar(&c.SendQueueSize, "send-queue-size", c.SendQueueSize, "per-player outbound queue size for droppable notifications")
+	fs.IntVar(&c.SendBacklog, "send-backlog", c.SendBacklog, "outbound queue length considered a backlog")
+	fs.DurationVar(&c.SendBacklogTimeout, "send-backlog-timeout", c.SendBacklogTimeout, "disconnect a player whose outbound queue stays backlogged this long, 0 disables")
+	fs.Float64Var(&c.MaxMoveSpeed, "max-move-speed", c.MaxMoveSpeed, "max player speed in units per second, 0 disables the check")
+	fs.Float64Var(&c.MoveTolerance, "move-tolerance", c.MoveTolerance, "extra distance allowed per move to absorb network jitter")
+	fs.Var(&c.WorldBounds, "world-bounds", "world bounds as minX,minY,minZ,maxX,maxY,maxZ")
+	fs.IntVar(&c.MaxMoveViolations, "max-move-violations", c.MaxMoveViolations, "kick a player after this many rejected moves, 0 disables kicking")
+	fs.BoolVar(&c.RequireLogin, "require-login", c.RequireLogin, "reject messages other than LOGIN_REQUEST until the player logs in")
+	fs.Float64Var(&c.MsgRate, "msg-rate", c.MsgRate, "messages per second allowed per player, 0 disables rate limiting")
+	fs.IntVar(&c.MsgBurst, "msg-burst", c.MsgBurst, "burst size for per-player message rate limiting")
+	fs.Var(c.MsgRateLimits, "msg-rate-limits", "per-message limits as MESSAGE_ID=count/duration[:burst], e.g. MOVE_REQUEST=30/s,CHAT_REQUEST=2/s,CREATE_ROOM_REQUEST=1/10s")
+	fs.IntVar(&c.MaxRateViolations, "max-rate-violations", c.MaxRateViolations, "disconnect a player after this many rate limit violations within a minute, 0 disables")
+	fs.BoolVar(&c.PanicKickPlayer, "panic-kick-player", c.PanicKickPlayer, "disconnect a player whose message handler panics")
+	fs.BoolVar(&c.PanicCloseRoom, "panic-close-room", c.PanicCloseRoom, "close a room whose event handler, tick or timer panics")
+}
+
+// 全局配置实例
+var Config = DefaultConfig()
@@ -0,0 +1,99 @@
+package main
+
+import pb "server/src/proto"
+
+// 自定义消息数据的大小上限
+const MaxCustomMessageSize = 8 * 1024
+
+// 自定义消息处理器, 在房间协程中执行, 返回 false 表示不再按 target 转发
+type CustomMessageHandler func(room *Room, player *Player, msg *pb.RoomCustomMessage) bool
+
+// 自定义消息管理器
+type CustomMessageManager struct {
+	handlers map[uint32]CustomMessageHandler
+}
+
+func NewCustomMessageManager() *CustomMessageManager {
+	return &CustomMessageManager{
+		handlers: make(map[uint32]CustomMessageHandler),
+	}
+}
+
+// 注册自定义消息处理器
+func (m *CustomMessageManager) Register(subtype uint32, handler CustomMessageHandler) {
+	m.handlers[subtype] = handler
+}
+
+// 处理自定义消息, 没有注册处理器时直接转发
+func (m *CustomMessageManager) Handle(room *Room, player *Player, msg *pb.RoomCustomMessage) bool {
+	if handler, ok := m.handlers[msg.Subtype]; ok {
+		return handler(room, player, msg)
+	}
+	return true
+}
+
+// 全局自定义消息管理器实例
+var CustomMessages = NewCustomMessageManager()
+
+// 处理自定义消息并按 target 转发
+func (r *Room) HandleCustomMessage(event *Event) {
+	player, ok := r.Players[event.PlayerId]
+	if !ok {
+		return
+	}
+
+	msg := event.Payload.(*pb.RoomCustomMessage)
+	msg.SenderId = player.Id
+	if !CustomMessages.Handle(r, player, msg) {
+		return
+	}
+
+	r.RelayCustomMessage(msg)
+}
+
+// 按 msg.Target 转发自定义消息
+func (r *Room) RelayCustomMessage(msg *pb.RoomCustomMessage) {
+	if msg.Target == pb.RelayTarget_RELAY_NONE {
+		return
+	}
+
+	var targets map[string]struct{}
+	switch msg.Target {
+	case pb.RelayTarget_RELAY_PLAYERS:
+		targets = make(map[string]struct{}, len(msg.TargetPlayerIds))
+		for _, id := range msg.TargetPlayerIds {
+			targets[id] = struct{}{}
+		}
+	case pb.RelayTarget_RELAY_OWNER:
+		targets = map[string]struct{}{r.OwnerId: {}}
+	}
+
+	relay := mustEncodeFrame(&pb.Message{
+		Id:          pb.MessageId_ROOM_CUSTOM_MESSAGE,
+		MsgSerialNo: -1,
+		ClientId:    "",
+		Data: mustMarshal(&pb.RoomCustomMessage{
+			Subtype:  msg.Subtype,
+			Data:     msg.Data,
+			Target:   msg.Target,
+			SenderId: msg.SenderId,
+		}),
+	})
+
+	r.Mutex.Lock()
+	defer r.Mutex.Unlock()
+	for id, player := range r.Players {
+		switch msg.Target {
+		case pb.RelayTarget_RELAY_ALL:
+		case pb.RelayTarget_RELAY_OTHERS:
+			if id == msg.SenderId {
+				continue
+			}
+		default:
+			if _, ok := targets[id]; !ok {
+				continue
+			}
+		}
+		player.SendFrame(relay)
+	}
+}
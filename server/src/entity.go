@@ -0,0 +1,212 @@
+package main
+
+import (
+	"log"
+
+	"google.golang.org/protobuf/proto"
+	pb "server/src/proto"
+)
+
+// 房间内的非玩家对象, 只在房间协程中访问
+// 修改字段后需调用 Room.UpdateEntity 才会同步给客户端
+type Entity struct {
+	Id       uint64
+	Type     pb.EntityType
+	Position *pb.Position
+
+	// 组件, nil 表示没有该组件
+	Health   *pb.HealthComponent
+	Velocity *pb.VelocityComponent
+	Owner    *pb.OwnerComponent
+	Pickup   *pb.PickupComponent
+	Custom   []byte
+
+	version uint64 // 每次更新递增, 用于增量同步判断是否变化
+}
+
+// 转换为协议中的实体, 组件会被复制, 发出后可以继续修改实体
+func (e *Entity) ToProto() *pb.Entity {
+	entity := &pb.Entity{
+		Id:     e.Id,
+		Type:   e.Type,
+		Custom: append([]byte(nil), e.Custom...),
+	}
+	if e.Position != nil {
+		entity.Position = &pb.Position{X: e.Position.X, Y: e.Position.Y, Z: e.Position.Z}
+	}
+	if e.Health != nil {
+		entity.Health = proto.Clone(e.Health).(*pb.HealthComponent)
+	}
+	if e.Velocity != nil {
+		entity.Velocity = proto.Clone(e.Velocity).(*pb.VelocityComponent)
+	}
+	if e.Owner != nil {
+		entity.Owner = proto.Clone(e.Owner).(*pb.OwnerComponent)
+	}
+	if e.Pickup != nil {
+		entity.Pickup = proto.Clone(e.Pickup).(*pb.PickupComponent)
+	}
+	return entity
+}
+
+// 尚未通知客户端的实体变化
+type entityChanges struct {
+	spawned   map[uint64]struct{}
+	updated   map[uint64]struct{}
+	despawned []*Entity
+}
+
+func (c *entityChanges) empty() bool {
+	return len(c.spawned) == 0 && len(c.updated) == 0 && len(c.despawned) == 0
+}
+
+// 创建实体并分配 id, init 用于在通知发出前设置组件
+func (r *Room) SpawnEntity(entityType pb.EntityType, pos *pb.Position, init func(e *Entity)) *Entity {
+	r.nextEntityId++
+	e := &Entity{
+		Id:       r.nextEntityId,
+		Type:     entityType,
+		Position: pos,
+		version:  1,
+	}
+	if init != nil {
+		init(e)
+	}
+	r.Entities[e.Id] = e
+	r.entityChanges.spawned[e.Id] = struct{}{}
+	log.Printf("Entity %d (%s) spawned in room %s", e.Id, e.Type, r.Name)
+
+	r.flushEntitiesIfEventDriven()
+	return e
+}
+
+// 获取实体
+func (r *Room) GetEntity(id uint64) (*Entity, bool) {
+	e, ok := r.Entities[id]
+	return e, ok
+}
+
+// 标记实体已修改, 同步给客户端
+func (r *Room) UpdateEntity(id uint64) {
+	e, ok := r.Entities[id]
+	if !ok {
+		return
+	}
+	e.version++
+	if _, spawned := r.entityChanges.spawned[id]; !spawned {
+		r.entityChanges.updated[id] = struct{}{}
+	}
+	r.flushEntitiesIfEventDriven()
+}
+
+// 销毁实体
+func (r *Room) DespawnEntity(id uint64) {
+	e, ok := r.Entities[id]
+	if !ok {
+		return
+	}
+	delete(r.Entities, id)
+	delete(r.entityChanges.updated, id)
+	if _, spawned := r.entityChanges.spawned[id]; spawned {
+		// 还没通知过客户端, 直接丢弃
+		delete(r.entityChanges.spawned, id)
+	} else {
+		r.entityChanges.despawned = append(r.entityChanges.despawned, e)
+	}
+	log.Printf("Entity %d despawned in room %s", id, r.Name)
+
+	r.flushEntitiesIfEventDriven()
+}
+
+// 事件驱动房间立即通知, 帧模式房间在每帧末尾统一通知
+func (r *Room) flushEntitiesIfEventDriven() {
+	if r.TickRate != 0 {
+		return
+	}
+	if r.DeltaSync {
+		r.resetEntityChanges()
+		r.BroadcastState("")
+		return
+	}
+	r.flushEntityChanges()
+}
+
+// 发送实体的创建/更新/销毁通知; 增量同步房间由 StateDeltaNotification 携带实体状态
+func (r *Room) flushEntityChanges() {
+	changes := &r.entityChanges
+	if changes.empty() {
+		return
+	}
+	defer r.resetEntityChanges()
+	if r.DeltaSync {
+		return
+	}
+
+	r.Mutex.Lock()
+	defer r.Mutex.Unlock()
+	for id, player := range r.Players {
+		var spawned, updated []*pb.Entity
+		var despawned []uint64
+		for entityId := range changes.spawned {
+			if e := r.Entities[entityId]; r.CanSeeEntity(id, e) {
+				spawned = append(spawned, e.ToProto())
+			}
+		}
+		for entityId := range changes.updated {
+			if e := r.Entities[entityId]; r.CanSeeEntity(id, e) {
+				updated = append(updated, e.ToProto())
+			}
+		}
+		for _, e := range changes.despawned {
+			if r.CanSeeEntity(id, e) {
+				despawned = append(despawned, e.Id)
+			}
+		}
+
+		if len(spawned) > 0 {
+			player.SendMessage(&pb.Message{
+				Id:          pb.MessageId_ENTITY_SPAWN_NOTIFICATION,
+				MsgSerialNo: -1,
+				ClientId:    "",
+				Data:        mustMarshal(&pb.EntitySpawnNotification{Entities: spawned}),
+			})
+		}
+		if len(updated) > 0 {
+			player.SendMessage(&pb.Message{
+				Id:          pb.MessageId_ENTITY_UPDATE_NOTIFICATION,
+				MsgSerialNo: -1,
+				ClientId:    "",
+				Data:        mustMarshal(&pb.EntityUpdateNotification{Entities: updated}),
+			})
+		}
+		if len(despawned) > 0 {
+			player.SendMessage(&pb.Message{
+				Id:          pb.MessageId_ENTITY_DESPAWN_NOTIFICATION,
+				MsgSerialNo: -1,
+				ClientId:    "",
+				Data:        mustMarshal(&pb.EntityDespawnNotification{EntityIds: despawned}),
+			})
+		}
+	}
+}
+
+func (r *Room) resetEntityChanges() {
+	r.entityChanges = entityChanges{
+		spawned: make(map[uint64]struct{}),
+		updated: make(map[uint64]struct{}),
+	}
+}
+
+// 玩家 viewerId 是否能看到实体, 未开启视野过滤时总是可见
+func (r *Room) CanSeeEntity(viewerId string, e *Entity) bool {
+	if r.aoi == nil {
+		return true
+	}
+	viewer, ok := r.Players[viewerId]
+	if !ok || e.Position == nil {
+		return true
+	}
+	dx := e.Position.X - viewer.Position.GetX()
+	dy := e.Position.Y - viewer.Position.GetY()
+	return dx*dx+dy*dy <= r.AoiRadius*r.AoiRadius
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	pb "server/src/proto"
+)
+
+// 带错误码的业务错误, 处理器返回它时框架会把错误码写入响应
+type GameError struct {
+	Code    pb.ErrorCode
+	Message string
+}
+
+func (e *GameError) Error() string {
+	if e.Message == "" {
+		return e.Code.String()
+	}
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+// 创建业务错误, format 为空时只携带错误码
+func NewGameError(code pb.ErrorCode, format string, args ...interface{}) *GameError {
+	return &GameError{Code: code, Message: fmt.Sprintf(format, args...)}
+}
+
+// 从 err 中取出错误码, 非业务错误视为内部错误
+func ErrorCodeOf(err error) pb.ErrorCode {
+	if err == nil {
+		return pb.ErrorCode_OK
+	}
+	var gameErr *GameError
+	if errors.As(err, &gameErr) {
+		return gameErr.Code
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return pb.ErrorCode_REQUEST_TIMEOUT
+	}
+	return pb.ErrorCode_INTERNAL_ERROR
+}
+
+// 可以返回给客户端的错误描述, 内部错误不暴露细节
+func ErrorMessageOf(err error) string {
+	var gameErr *GameError
+	if errors.As(err, &gameErr) {
+		return gameErr.Message
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return "request timed out"
+	}
+	return "internal error"
+}
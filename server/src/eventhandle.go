@@ -1,5 +1,12 @@
 package main
 
+import (
+	"context"
+	"fmt"
+
+	pb "server/src/proto"
+)
+
 type EventType int
 
 const (
@@ -7,15 +14,89 @@ const (
 	EventLeaveRoom
 	EventChat
 	EventMove
+	EventSwitchTeam
+	EventStateAck
+	EventFrameInput
+	EventFrameHistory
+	EventPlayerInput
+	EventRoomMessage
+	EventCustomMessage
 )
 
 type Event struct {
 	Type         EventType
 	PlayerId     string
+	Ctx          context.Context // 请求的 ctx, 取消后房间不再处理该事件(离开房间除外)
 	Payload      interface{}
 	ResponseChan chan interface{} // 用于向玩家协程返回结果
 }
 
+// 房间处理失败时通知等待方, 已经回复过时忽略
+func (e *Event) Fail(err error) {
+	if e.ResponseChan == nil {
+		return
+	}
+	select {
+	case e.ResponseChan <- err:
+	default:
+	}
+}
+
+// 向房间协程投递事件, 不等待处理结果
+// 房间已关闭或在 ctx 结束前房间一直没有空位时返回 ROOM_UNAVAILABLE
+func (r *Room) Post(ctx context.Context, event *Event) error {
+	if event.Ctx == nil {
+		event.Ctx = ctx
+	}
+	select {
+	case r.EventChan <- event:
+		return nil
+	case <-r.done:
+		return NewGameError(pb.ErrorCode_ROOM_UNAVAILABLE, "room %s closed", r.Name)
+	case <-ctx.Done():
+		return NewGameError(pb.ErrorCode_ROOM_UNAVAILABLE, "room %s busy: %v", r.Name, ctx.Err())
+	}
+}
+
+// 向房间协程发送请求并等待结果, 事件的 Ctx 和 ResponseChan 由这里设置
+// ctx 没有截止时间时使用 Config.RequestTimeout; 房间关闭或超时返回 ROOM_UNAVAILABLE
+func RoomRequest[T any](ctx context.Context, room *Room, event *Event) (T, error) {
+	var zero T
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, Config.RequestTimeout)
+		defer cancel()
+	}
+	event.Ctx = ctx
+	event.ResponseChan = make(chan interface{}, 1)
+	if err := room.Post(ctx, event); err != nil {
+		return zero, err
+	}
+
+	var result interface{}
+	select {
+	case result = <-event.ResponseChan:
+	case <-room.done:
+		// 房间可能在退出前已经回复
+		select {
+		case result = <-event.ResponseChan:
+		default:
+			return zero, NewGameError(pb.ErrorCode_ROOM_UNAVAILABLE, "room %s closed", room.Name)
+		}
+	case <-ctx.Done():
+		return zero, NewGameError(pb.ErrorCode_ROOM_UNAVAILABLE, "room %s did not respond: %v", room.Name, ctx.Err())
+	}
+
+	switch resp := result.(type) {
+	case error:
+		return zero, resp
+	case T:
+		return resp, nil
+	default:
+		return zero, fmt.Errorf("unexpected response %T for event %d", resp, event.Type)
+	}
+}
+
 // 事件管理器
 type EventManager struct {
 	event_handlers map[EventType]func(room *Room, event *Event)
@@ -50,4 +131,11 @@ func InitEventHandlers() {
 	EventHandler.Register(EventLeaveRoom, (*Room).HandleLeaveRoom)
 	EventHandler.Register(EventChat, (*Room).HandleChat)
 	EventHandler.Register(EventMove, (*Room).HandleMove)
+	EventHandler.Register(EventSwitchTeam, (*Room).HandleSwitchTeam)
+	EventHandler.Register(EventStateAck, (*Room).HandleStateAck)
+	EventHandler.Register(EventFrameInput, (*Room).HandleFrameInput)
+	EventHandler.Register(EventFrameHistory, (*Room).HandleFrameHistory)
+	EventHandler.Register(EventPlayerInput, (*Room).HandlePlayerInput)
+	EventHandler.Register(EventRoomMessage, (*Room).HandleRoomMessage)
+	EventHandler.Register(EventCustomMessage, (*Room).HandleCustomMessage)
 }
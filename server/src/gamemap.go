@@ -0,0 +1,121 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// 轴对齐包围盒
+type AABB struct {
+	Min [3]float32 `json:"min"`
+	Max [3]float32 `json:"max"`
+}
+
+// 两个包围盒是否相交（贴边不算相交）
+func (b AABB) Intersects(o AABB) bool {
+	for i := 0; i < 3; i++ {
+		if b.Max[i] <= o.Min[i] || b.Min[i] >= o.Max[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// 静态地图, 从地图目录下的 <name>.json 加载
+type GameMap struct {
+	Name         string     `json:"-"`
+	Bounds       *AABB      `json:"bounds"`       // 可活动范围, 为空表示不限制
+	Obstacles    []AABB     `json:"obstacles"`    // 静态障碍物
+	MaxSpeed     float32    `json:"maxSpeed"`     // 最大速度(单位/秒)
+	Acceleration float32    `json:"acceleration"` // 加速度(单位/秒²), 0 表示速度立即到位
+	PlayerSize   [3]float32 `json:"playerSize"`   // 玩家包围盒尺寸
+	Spawn        [3]float32 `json:"spawn"`        // 玩家进入房间时的出生点
+}
+
+// 地图未配置最大速度时使用的默认值
+const DefaultMapMaxSpeed = 5
+
+// 已加载的地图, name -> *GameMap
+var mapCache sync.Map
+
+// 加载地图, 同名地图只加载一次; name 为空时返回无障碍的空地图
+func LoadGameMap(name string) (*GameMap, error) {
+	if name == "" {
+		return newEmptyMap(), nil
+	}
+	// 地图名由客户端提供, 不允许跳出地图目录
+	if strings.ContainsAny(name, `/\`) || strings.Contains(name, "..") {
+		return nil, fmt.Errorf("invalid map name %q", name)
+	}
+	if m, ok := mapCache.Load(name); ok {
+		return m.(*GameMap), nil
+	}
+
+	data, err := os.ReadFile(filepath.Join(Config.MapDir, name+".json"))
+	if err != nil {
+		return nil, err
+	}
+	m := newEmptyMap()
+	if err := json.Unmarshal(data, m); err != nil {
+		return nil, fmt.Errorf("parse map %s: %v", name, err)
+	}
+	m.Name = name
+	if m.MaxSpeed <= 0 {
+		m.MaxSpeed = DefaultMapMaxSpeed
+	}
+
+	actual, _ := mapCache.LoadOrStore(name, m)
+	return actual.(*GameMap), nil
+}
+
+func newEmptyMap() *GameMap {
+	return &GameMap{
+		MaxSpeed:   DefaultMapMaxSpeed,
+		PlayerSize: [3]float32{1, 1, 1},
+	}
+}
+
+// 以 pos 为中心的玩家包围盒
+func (m *GameMap) playerBox(pos [3]float32) AABB {
+	var box AABB
+	for i := 0; i < 3; i++ {
+		box.Min[i] = pos[i] - m.PlayerSize[i]/2
+		box.Max[i] = pos[i] + m.PlayerSize[i]/2
+	}
+	return box
+}
+
+// 玩家在 pos 处是否与障碍物相交
+func (m *GameMap) Collides(pos [3]float32) bool {
+	box := m.playerBox(pos)
+	for _, obstacle := range m.Obstacles {
+		if box.Intersects(obstacle) {
+			return true
+		}
+	}
+	return false
+}
+
+// 把坐标限制在地图范围内
+func (m *GameMap) Clamp(pos [3]float32) [3]float32 {
+	if m.Bounds == nil {
+		return pos
+	}
+	for i := 0; i < 3; i++ {
+		lo := m.Bounds.Min[i] + m.PlayerSize[i]/2
+		hi := m.Bounds.Max[i] - m.PlayerSize[i]/2
+		if lo > hi {
+			lo, hi = m.Bounds.Min[i], m.Bounds.Max[i]
+		}
+		if pos[i] < lo {
+			pos[i] = lo
+		} else if pos[i] > hi {
+			pos[i] = hi
+		}
+	}
+	return pos
+}
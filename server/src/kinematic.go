@@ -0,0 +1,110 @@
+package main
+
+import (
+	"log"
+	"math"
+	"time"
+
+	pb "server/src/proto"
+)
+
+// 权威模拟未指定帧率时使用的默认帧率
+const DefaultSimulationRate = 20
+
+// 权威模拟中玩家的运动状态, 只在房间协程中访问
+type kinematicState struct {
+	velocity     [3]float32
+	input        *pb.PlayerInputRequest // 最近一次输入, 持续生效直到收到新输入
+	lastInputSeq uint32                 // 已处理的最新输入序号
+}
+
+// 记录玩家输入, 丢弃乱序的旧输入
+func (r *Room) HandlePlayerInput(event *Event) {
+	if !r.Authoritative {
+		return
+	}
+	player, ok := r.Players[event.PlayerId]
+	if !ok {
+		return
+	}
+
+	req := event.Payload.(*pb.PlayerInputRequest)
+	if req.Seq <= player.kinematic.lastInputSeq {
+		return
+	}
+	// NaN 会绕过速度上限的比较, 一旦进入速度就无法恢复
+	if math.IsNaN(float64(req.Speed)) || math.IsInf(float64(req.Speed), 0) {
+		log.Printf("Player %s sent invalid input speed %v, ignored", player.Id, req.Speed)
+		return
+	}
+	player.kinematic.input = req
+	player.kinematic.lastInputSeq = req.Seq
+}
+
+// 按输入积分所有玩家的位置, 每帧调用一次
+func (r *Room) simulate(dt time.Duration) {
+	seconds := float32(dt.Seconds())
+	for _, player := range r.Players {
+		r.integrate(player, seconds)
+	}
+}
+
+func (r *Room) integrate(player *Player, dt float32) {
+	m := r.Map
+	ks := &player.kinematic
+
+	// 由输入计算目标速度
+	var target [3]float32
+	if in := ks.input; in != nil && in.Direction != nil {
+		dir := [3]float32{in.Direction.X, in.Direction.Y, in.Direction.Z}
+		length := float32(math.Sqrt(float64(dir[0]*dir[0] + dir[1]*dir[1] + dir[2]*dir[2])))
+		speed := in.Speed
+		if speed > m.MaxSpeed || speed < 0 {
+			speed = m.MaxSpeed
+		}
+		if length > 0 && !math.IsNaN(float64(length)) && !math.IsInf(float64(length), 0) {
+			for i := 0; i < 3; i++ {
+				target[i] = dir[i] / length * speed
+			}
+		}
+	}
+
+	// 按加速度逼近目标速度
+	if m.Acceleration <= 0 {
+		ks.velocity = target
+	} else {
+		step := m.Acceleration * dt
+		for i := 0; i < 3; i++ {
+			diff := target[i] - ks.velocity[i]
+			if diff > step {
+				diff = step
+			} else if diff < -step {
+				diff = -step
+			}
+			ks.velocity[i] += diff
+		}
+	}
+
+	if ks.velocity == [3]float32{} {
+		return
+	}
+
+	// 逐轴移动, 与障碍物相交时撤销该轴的移动并清零该轴速度
+	pos := [3]float32{player.Position.GetX(), player.Position.GetY(), player.Position.GetZ()}
+	for i := 0; i < 3; i++ {
+		if ks.velocity[i] == 0 {
+			continue
+		}
+		old := pos[i]
+		pos[i] += ks.velocity[i] * dt
+		if m.Collides(pos) {
+			pos[i] = old
+			ks.velocity[i] = 0
+		}
+	}
+	pos = m.Clamp(pos)
+
+	// 替换而不是修改 Position, 已发出的消息可能仍在其他协程中序列化
+	player.Position = &pb.Position{X: pos[0], Y: pos[1], Z: pos[2]}
+	player.acceptMove(player.Position, time.Now())
+}
@@ -0,0 +1,126 @@
+package main
+
+import (
+	pb "server/src/proto"
+)
+
+// 帧同步模式下未指定帧率时使用的默认帧率
+const DefaultLockstepRate = 15
+
+// 单次历史帧响应最多包含的帧数
+const MaxFramesPerHistory = 1000
+
+// 每帧每个玩家最多接受的输入数量
+const MaxInputsPerFrame = 8
+
+// 保留的历史帧数量, 默认帧率下约 10 分钟, 更早的帧无法再拉取
+const FrameHistorySize = 9000
+
+// 帧同步状态, 只在房间协程中访问
+type lockstepState struct {
+	nextFrame uint32                      // 下一个要下发的帧号
+	pending   map[uint32][]*pb.FrameInput // 按生效帧号缓存的输入
+	frames    []*pb.Frame                 // 最近下发的帧, 环形缓冲, 帧号对 FrameHistorySize 取模为下标
+}
+
+func newLockstepState() *lockstepState {
+	return &lockstepState{
+		pending: make(map[uint32][]*pb.FrameInput),
+	}
+}
+
+func (r *Room) IsLockstep() bool {
+	return r.Mode == pb.RoomMode_MODE_LOCKSTEP
+}
+
+// 生成并广播一帧, 没有输入时下发空帧
+func (r *Room) stepFrame() {
+	ls := r.lockstep
+	frame := &pb.Frame{
+		FrameId: ls.nextFrame,
+		Inputs:  ls.pending[ls.nextFrame],
+	}
+	delete(ls.pending, ls.nextFrame)
+	if len(ls.frames) < FrameHistorySize {
+		ls.frames = append(ls.frames, frame)
+	} else {
+		ls.frames[ls.nextFrame%FrameHistorySize] = frame
+	}
+	ls.nextFrame++
+
+	r.Broadcast("", &pb.Message{
+		Id:          pb.MessageId_FRAME_NOTIFICATION,
+		MsgSerialNo: -1,
+		ClientId:    "",
+		Data: mustMarshal(&pb.FrameNotification{
+			Frames: []*pb.Frame{frame},
+		}),
+	})
+}
+
+// 收集玩家输入, 在 InputDelay 帧之后生效
+func (r *Room) HandleFrameInput(event *Event) {
+	if !r.IsLockstep() {
+		return
+	}
+	if _, ok := r.Players[event.PlayerId]; !ok {
+		return
+	}
+
+	ls := r.lockstep
+	target := ls.nextFrame + uint32(r.InputDelay)
+	count := 0
+	for _, input := range ls.pending[target] {
+		if input.PlayerId == event.PlayerId {
+			count++
+		}
+	}
+	if count >= MaxInputsPerFrame {
+		return
+	}
+
+	req := event.Payload.(*pb.FrameInputRequest)
+	ls.pending[target] = append(ls.pending[target], &pb.FrameInput{
+		PlayerId: event.PlayerId,
+		Command:  req.Command,
+	})
+}
+
+// 返回 fromFrame 开始的历史帧
+func (r *Room) HandleFrameHistory(event *Event) {
+	if !r.IsLockstep() {
+		event.ResponseChan <- &pb.FrameHistoryResponse{Ret: pb.ErrorCode_ROOM_MODE_MISMATCH}
+		return
+	}
+
+	ls := r.lockstep
+	req := event.Payload.(*pb.FrameHistoryRequest)
+	oldest := ls.nextFrame - uint32(len(ls.frames))
+	if req.FromFrame < oldest {
+		event.ResponseChan <- &pb.FrameHistoryResponse{
+			Ret:          pb.ErrorCode_FRAME_HISTORY_EXPIRED,
+			CurrentFrame: ls.nextFrame,
+			OldestFrame:  oldest,
+		}
+		return
+	}
+	from := req.FromFrame
+	if from > ls.nextFrame {
+		from = ls.nextFrame
+	}
+	to := from + MaxFramesPerHistory
+	if to > ls.nextFrame {
+		to = ls.nextFrame
+	}
+
+	frames := make([]*pb.Frame, 0, to-from)
+	for id := from; id < to; id++ {
+		frames = append(frames, ls.frames[id%FrameHistorySize])
+	}
+	event.ResponseChan <- &pb.FrameHistoryResponse{
+		Ret:          pb.ErrorCode_OK,
+		Frames:       frames,
+		CurrentFrame: ls.nextFrame,
+		OldestFrame:  oldest,
+	}
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"testing"
+
+	pb "server/src/proto"
+)
+
+func requestFrameHistory(room *Room, from uint32) *pb.FrameHistoryResponse {
+	event := &Event{
+		Payload:      &pb.FrameHistoryRequest{FromFrame: from},
+		ResponseChan: make(chan interface{}, 1),
+	}
+	room.HandleFrameHistory(event)
+	return (<-event.ResponseChan).(*pb.FrameHistoryResponse)
+}
+
+// 历史帧超过 FrameHistorySize 后淘汰最旧的帧, 拉取已淘汰的帧时回复 FRAME_HISTORY_EXPIRED
+func TestFrameHistoryRing(t *testing.T) {
+	room := NewRoom(1, "test", RoomConfig{Mode: pb.RoomMode_MODE_LOCKSTEP})
+	total := uint32(FrameHistorySize + 10)
+	for i := uint32(0); i < total; i++ {
+		room.stepFrame()
+	}
+	if len(room.lockstep.frames) != FrameHistorySize {
+		t.Fatalf("kept %d frames, want %d", len(room.lockstep.frames), FrameHistorySize)
+	}
+
+	rsp := requestFrameHistory(room, 5)
+	if rsp.Ret != pb.ErrorCode_FRAME_HISTORY_EXPIRED || rsp.OldestFrame != 10 || rsp.CurrentFrame != total {
+		t.Fatalf("expired request = %v", rsp)
+	}
+
+	rsp = requestFrameHistory(room, 10)
+	if rsp.Ret != pb.ErrorCode_OK || len(rsp.Frames) != MaxFramesPerHistory {
+		t.Fatalf("oldest request = ret %v, %d frames", rsp.Ret, len(rsp.Frames))
+	}
+	for i, frame := range rsp.Frames {
+		if frame.FrameId != uint32(10+i) {
+			t.Fatalf("frame %d has id %d", i, frame.FrameId)
+		}
+	}
+
+	rsp = requestFrameHistory(room, total-3)
+	if rsp.Ret != pb.ErrorCode_OK || len(rsp.Frames) != 3 || rsp.Frames[2].FrameId != total-1 {
+		t.Fatalf("latest request = %v", rsp)
+	}
+}
@@ -1,13 +1,35 @@
 package main
 
 import (
+	"errors"
+	"flag"
 	"fmt"
 	"log"
 	"net"
+	"os"
+	"os/signal"
+	"syscall"
 )
 
 func handleConnection(conn net.Conn) {
+	// 经过代理时先解析 PROXY 头, 之后 conn.RemoteAddr 为客户端真实地址
+	proxied, err := acceptProxyHeader(conn)
+	if err != nil {
+		log.Printf("Rejected connection from %s: %v", conn.RemoteAddr(), err)
+		conn.Close()
+		return
+	}
+	conn = proxied
+	release, reason := Admission.Admit(remoteAddr(conn))
+	if reason != "" {
+		log.Printf("Rejected connection from %s: %s", conn.RemoteAddr(), reason)
+		conn.Close()
+		return
+	}
+	defer release()
+
 	connID := GenerateConnID(conn)
+	log.Printf("Player %s connected from %s", connID, conn.RemoteAddr())
 	defer GlobalManager.DeletePlayer(connID)
 
 	player := GlobalManager.GetOrCreatePlayer(connID, conn)
@@ -17,23 +39,62 @@ func handleConnection(conn net.Conn) {
 }
 
 func main() {
+	Config.RegisterFlags(flag.CommandLine)
+	flag.Parse()
+	if err := Config.Validate(); err != nil {
+		log.Fatal("Invalid configuration: ", err)
+	}
+
 	// 初始化消息处理器
-	InitMessageHandlers()
+	if err := InitMessageHandlers(); err != nil {
+		log.Fatal("Invalid message handler registry:\n", err)
+	}
+	InitEventHandlers()
+	InitRoomLogics()
+
+	if err := Admission.ReloadAccessList(); err != nil {
+		log.Fatal("Failed to load access list:", err)
+	}
 
 	// 启动服务器
-	listener, err := net.Listen("tcp", ":12345")
+	listener, err := net.Listen("tcp", Config.ListenAddr)
 	if err != nil {
 		log.Fatal("Failed to start server:", err)
 	}
-	defer listener.Close()
-	fmt.Println("Server started at :12345")
+	fmt.Println("Server started at", Config.ListenAddr)
+
+	// 收到停服信号后关闭监听, Accept 返回后进入停服流程
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGTERM, syscall.SIGINT)
+	stopping := make(chan string, 1)
+	go func() {
+		sig := <-signals
+		stopping <- fmt.Sprintf("server shutting down (%s)", sig)
+		listener.Close()
+	}()
+
+	// 收到 SIGHUP 时重新加载访问控制列表
+	reloads := make(chan os.Signal, 1)
+	signal.Notify(reloads, syscall.SIGHUP)
+	go func() {
+		for range reloads {
+			if err := Admission.ReloadAccessList(); err != nil {
+				log.Println("Failed to reload access list, keeping the old one:", err)
+			}
+		}
+	}()
 
 	for {
 		conn, err := listener.Accept()
 		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				break
+			}
 			log.Println("Failed to accept connection:", err)
 			continue
 		}
 		go handleConnection(conn)
 	}
+
+	Shutdown(<-stopping)
 }
@@ -19,8 +19,8 @@ func IncrementAndGetRoomCounter() uint64 {
 }
 
 // 创建房间
-func (rm *Manager) GetOrCreateRoom(id uint64, name string) *Room {
-	room, loaded := rm.rooms.LoadOrStore(id, NewRoom(id, name)) // 从 sync.Map 获取房间，如果不存在则创建
+func (rm *Manager) GetOrCreateRoom(id uint64, name string, config RoomConfig) *Room {
+	room, loaded := rm.rooms.LoadOrStore(id, NewRoom(id, name, config)) // 从 sync.Map 获取房间，如果不存在则创建
 	// 如果房间是新创建的，则启动其协程
 	if !loaded {
 		log.Printf("Room created: %s", name)
@@ -44,12 +44,21 @@ func (rm *Manager) GetRoom(id uint64) (*Room, bool) {
 // 删除房间
 func (rm *Manager) DeleteRoom(id uint64) {
 	if room, ok := rm.GetRoom(id); ok {
-		room.QuitChan <- true // 关闭房间
-		rm.rooms.Delete(id)   // 删除房间
+		select {
+		case room.QuitChan <- true: // 关闭房间
+		case <-room.Done(): // 房间协程已经退出
+		}
+		rm.rooms.Delete(id) // 删除房间
 		log.Printf("Room %d deleted", id)
 	}
 }
 
+// 只从房间列表中移除, 不通知房间协程退出
+func (rm *Manager) RemoveRoom(id uint64) {
+	rm.rooms.Delete(id)
+	log.Printf("Room %d removed", id)
+}
+
 // 获取所有房间
 func (rm *Manager) GetAllRooms() []*Room {
 	var rooms []*Room
@@ -88,8 +97,8 @@ func (rm *Manager) GetPlayer(id string) (*Player, bool) {
 // 删除玩家
 func (rm *Manager) DeletePlayer(id string) {
 	if player, ok := rm.GetPlayer(id); ok {
-		player.QuitChan <- true // 退出玩家
-		rm.players.Delete(id)   // 删除玩家
+		player.Quit()         // 退出玩家
+		rm.players.Delete(id) // 删除玩家
 		log.Printf("Player %s deleted", id)
 	}
 }
@@ -0,0 +1,193 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"runtime/debug"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	pb "server/src/proto"
+)
+
+// 捕获处理器中的 panic, 避免一个消息拖垮整个服务器
+// 回复内部错误, 开启 PanicKickPlayer 时只断开出错的玩家
+func Recover() Middleware {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, player *Player, msg *pb.Message) {
+			defer func() {
+				if r := recover(); r != nil {
+					room := "none"
+					if player.Room != nil {
+						room = fmt.Sprintf("%d (%s)", player.Room.ID, player.Room.Name)
+					}
+					log.Printf("[trace %d] Panic handling %s (serial %d) from player %s in room %s: %v\n%s",
+						TraceIdFrom(ctx), msg.GetId(), msg.GetMsgSerialNo(), player.Id, room, r, debug.Stack())
+					player.SendError(msg, pb.ErrorCode_INTERNAL_ERROR, "internal error")
+					if Config.PanicKickPlayer {
+						player.Kick("internal error")
+					}
+				}
+			}()
+			next(ctx, player, msg)
+		}
+	}
+}
+
+type traceIdKey struct{}
+
+var traceCounter uint64
+
+// 取出请求的追踪 id, 没有时返回 0
+func TraceIdFrom(ctx context.Context) uint64 {
+	id, _ := ctx.Value(traceIdKey{}).(uint64)
+	return id
+}
+
+// 为每个请求分配追踪 id, 放入 ctx 供后续中间件和处理器使用
+func Tracing() Middleware {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, player *Player, msg *pb.Message) {
+			ctx = context.WithValue(ctx, traceIdKey{}, atomic.AddUint64(&traceCounter, 1))
+			next(ctx, player, msg)
+		}
+	}
+}
+
+// 记录每个请求及其耗时
+func Logging() Middleware {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, player *Player, msg *pb.Message) {
+			start := time.Now()
+			next(ctx, player, msg)
+			log.Printf("[trace %d] Player %s %s (serial %d, %d bytes) handled in %v",
+				TraceIdFrom(ctx), player.Id, msg.GetId(), msg.GetMsgSerialNo(), len(msg.GetData()), time.Since(start))
+		}
+	}
+}
+
+// 单个消息的处理统计
+type MessageStat struct {
+	Count         uint64 // 处理次数
+	TotalDuration int64  // 累计耗时(纳秒)
+	MaxDuration   int64  // 最长耗时(纳秒)
+}
+
+// 按消息 id 统计处理次数和耗时, 可在任意协程中读取
+type MessageStats struct {
+	stats sync.Map // pb.MessageId -> *MessageStat
+}
+
+func (s *MessageStats) record(msgId pb.MessageId, cost time.Duration) {
+	value, ok := s.stats.Load(msgId)
+	if !ok {
+		value, _ = s.stats.LoadOrStore(msgId, &MessageStat{})
+	}
+	stat := value.(*MessageStat)
+	atomic.AddUint64(&stat.Count, 1)
+	atomic.AddInt64(&stat.TotalDuration, int64(cost))
+	for {
+		old := atomic.LoadInt64(&stat.MaxDuration)
+		if int64(cost) <= old || atomic.CompareAndSwapInt64(&stat.MaxDuration, old, int64(cost)) {
+			break
+		}
+	}
+}
+
+// 获取所有消息的统计快照
+func (s *MessageStats) Snapshot() map[pb.MessageId]MessageStat {
+	result := make(map[pb.MessageId]MessageStat)
+	s.stats.Range(func(key, value interface{}) bool {
+		stat := value.(*MessageStat)
+		result[key.(pb.MessageId)] = MessageStat{
+			Count:         atomic.LoadUint64(&stat.Count),
+			TotalDuration: atomic.LoadInt64(&stat.TotalDuration),
+			MaxDuration:   atomic.LoadInt64(&stat.MaxDuration),
+		}
+		return true
+	})
+	return result
+}
+
+// 全局消息统计实例
+var MsgStats = &MessageStats{}
+
+// 把处理次数和耗时记录到 stats
+func Metrics(stats *MessageStats) Middleware {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, player *Player, msg *pb.Message) {
+			start := time.Now()
+			next(ctx, player, msg)
+			stats.record(msg.GetId(), time.Since(start))
+		}
+	}
+}
+
+// 玩家登录前只放行 allowed 中的消息
+func RequireLogin(allowed ...pb.MessageId) Middleware {
+	allow := make(map[pb.MessageId]struct{}, len(allowed))
+	for _, id := range allowed {
+		allow[id] = struct{}{}
+	}
+	return func(next Handler) Handler {
+		return func(ctx context.Context, player *Player, msg *pb.Message) {
+			if _, ok := allow[msg.GetId()]; !ok && !player.loggedIn {
+				log.Printf("Player %s sent %s before login, rejected", player.Id, msg.GetId())
+				// 单个消息的限流在登录检查之内, 错误回复允许在队列满时丢弃
+				player.SendErrorWithPriority(msg, pb.ErrorCode_NOT_LOGGED_IN, "", PriorityNormal)
+				return
+			}
+			next(ctx, player, msg)
+		}
+	}
+}
+
+// 超限次数的统计窗口
+const rateViolationWindow = time.Minute
+
+// 记录一次超限, 窗口内次数过多时断开玩家
+func (p *Player) recordRateViolation() {
+	now := time.Now()
+	if now.Sub(p.rateViolationsSince) > rateViolationWindow {
+		p.rateViolations = 0
+		p.rateViolationsSince = now
+	}
+	p.rateViolations++
+	if Config.MaxRateViolations > 0 && p.rateViolations == Config.MaxRateViolations {
+		p.Kick("rate limit exceeded")
+	}
+}
+
+// 每个 RateLimit 中间件在玩家身上有独立的令牌桶
+type rateLimitKey struct {
+	rate  float64
+	burst int
+}
+
+// 按玩家限制消息速率, 超出的消息被丢弃并回复 RATE_LIMITED
+// 一分钟内超限次数达到 Config.MaxRateViolations 时断开玩家
+func RateLimit(rate float64, burst int) Middleware {
+	key := &rateLimitKey{rate: rate, burst: burst}
+	return func(next Handler) Handler {
+		return func(ctx context.Context, player *Player, msg *pb.Message) {
+			if player.rateLimiters == nil {
+				player.rateLimiters = make(map[*rateLimitKey]*TokenBucket)
+			}
+			bucket, ok := player.rateLimiters[key]
+			if !ok {
+				bucket = NewTokenBucket(key.rate, key.burst)
+				player.rateLimiters[key] = bucket
+			}
+			if !bucket.Allow() {
+				log.Printf("Player %s exceeded message rate, dropped %s", player.Id, msg.GetId())
+				// 超限的客户端可能持续刷屏, 错误回复允许在队列满时丢弃
+				player.SendErrorWithPriority(msg, pb.ErrorCode_RATE_LIMITED, "", PriorityNormal)
+				player.recordRateViolation()
+				return
+			}
+			next(ctx, player, msg)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	pb "server/src/proto"
+)
+
+// Recover 的日志带上 Tracing 分配的追踪 id 和玩家所在的房间, 并回复内部错误
+func TestRecoverLogsTraceAndRoom(t *testing.T) {
+	var out bytes.Buffer
+	log.SetOutput(&out)
+	defer log.SetOutput(os.Stderr)
+
+	m := NewMessageManager()
+	m.Use(Tracing(), Recover())
+	m.PlayerRegister(pb.MessageId_CHAT_REQUEST, func(ctx context.Context, player *Player, msg *pb.Message) {
+		panic("boom")
+	})
+	m.Build()
+
+	player := newTestPlayer(t, "p1")
+	player.Room = NewRoom(7, "arena", RoomConfig{})
+	m.PlayerHandle(player, &pb.Message{Id: pb.MessageId_CHAT_REQUEST})
+
+	line := out.String()
+	if strings.Contains(line, "[trace 0]") || !strings.Contains(line, "in room 7 (arena)") {
+		t.Fatalf("panic log = %q", line)
+	}
+	items := player.outbox.PopAll(nil)
+	if len(items) != 1 || items[0].msg.GetId() != pb.MessageId_ERROR_RESPONSE {
+		t.Fatalf("sent %v, want one ERROR_RESPONSE", items)
+	}
+}
+
+// 未登录的客户端同样受限流约束, 并且登录错误可以被丢弃
+func TestRateLimitBeforeLogin(t *testing.T) {
+	log.SetOutput(io.Discard)
+	defer log.SetOutput(os.Stderr)
+	oldConfig, oldHandler := Config, MsgHandler
+	defer func() { Config, MsgHandler = oldConfig, oldHandler }()
+	Config.RequireLogin = true
+	Config.MsgRate, Config.MsgBurst = 1, 1
+	Config.MaxRateViolations = 0
+	MsgHandler = NewMessageManager()
+	if err := InitMessageHandlers(); err != nil {
+		t.Fatal(err)
+	}
+
+	player := newTestPlayer(t, "p1")
+	for i := 0; i < 3; i++ {
+		MsgHandler.PlayerHandle(player, &pb.Message{Id: pb.MessageId_CHAT_REQUEST})
+	}
+
+	var codes []pb.ErrorCode
+	for _, item := range player.outbox.PopAll(nil) {
+		rsp := &pb.ErrorResponse{}
+		if err := proto.Unmarshal(item.msg.GetData(), rsp); err != nil {
+			t.Fatal(err)
+		}
+		codes = append(codes, rsp.Code)
+	}
+	want := []pb.ErrorCode{pb.ErrorCode_NOT_LOGGED_IN, pb.ErrorCode_RATE_LIMITED, pb.ErrorCode_RATE_LIMITED}
+	if fmt.Sprint(codes) != fmt.Sprint(want) {
+		t.Fatalf("errors = %v, want %v", codes, want)
+	}
+}
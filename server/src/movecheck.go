@@ -0,0 +1,71 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"time"
+
+	pb "server/src/proto"
+)
+
+// 校验移动请求, 通过时返回空字符串, 否则返回拒绝原因
+// 基准为玩家上一次被接受的位置和服务器接收时间, 不信任客户端时间戳
+func (r *Room) validateMove(player *Player, pos *pb.Position, now time.Time) string {
+	if pos == nil {
+		return "missing position"
+	}
+	if math.IsNaN(float64(pos.X)) || math.IsNaN(float64(pos.Y)) || math.IsNaN(float64(pos.Z)) ||
+		math.IsInf(float64(pos.X), 0) || math.IsInf(float64(pos.Y), 0) || math.IsInf(float64(pos.Z), 0) {
+		return "invalid position"
+	}
+
+	bounds := &Config.WorldBounds
+	if bounds.Enabled {
+		coords := [3]float32{pos.X, pos.Y, pos.Z}
+		for i, v := range coords {
+			if v < bounds.Min[i] || v > bounds.Max[i] {
+				return "out of world bounds"
+			}
+		}
+	}
+
+	if Config.MaxMoveSpeed > 0 && player.lastValidPos != nil {
+		dx := float64(pos.X - player.lastValidPos.X)
+		dy := float64(pos.Y - player.lastValidPos.Y)
+		dz := float64(pos.Z - player.lastValidPos.Z)
+		dist := math.Sqrt(dx*dx + dy*dy + dz*dz)
+		elapsed := now.Sub(player.lastMoveAt).Seconds()
+		if allowed := Config.MaxMoveSpeed*elapsed + Config.MoveTolerance; dist > allowed {
+			return fmt.Sprintf("moved %.2f in %.3fs, allowed %.2f", dist, elapsed, allowed)
+		}
+	}
+	return ""
+}
+
+// 记录被接受的移动, 作为下一次校验的基准
+func (p *Player) acceptMove(pos *pb.Position, now time.Time) {
+	p.lastValidPos = &pb.Position{X: pos.X, Y: pos.Y, Z: pos.Z}
+	p.lastMoveAt = now
+}
+
+// 拒绝移动: 通知客户端回退到上一个合法位置, 违规次数过多时踢出
+func (r *Room) rejectMove(player *Player, reason string) {
+	player.moveViolations++
+	log.Printf("Player %s move rejected in room %s: %s (violations: %d)", player.Id, r.Name, reason, player.moveViolations)
+
+	player.SendMessage(&pb.Message{
+		Id:          pb.MessageId_MOVE_CORRECTION_NOTIFICATION,
+		MsgSerialNo: -1,
+		ClientId:    "",
+		Data: mustMarshal(&pb.MoveCorrectionNotification{
+			Position:   player.lastValidPos,
+			Reason:     reason,
+			Violations: int32(player.moveViolations),
+		}),
+	})
+
+	if Config.MaxMoveViolations > 0 && player.moveViolations >= Config.MaxMoveViolations {
+		player.Kick("too many invalid moves")
+	}
+}
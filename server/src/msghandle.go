@@ -1,28 +1,230 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 	pb "server/src/proto"
 )
 
+// 消息处理器, 在玩家的消息处理协程中执行
+type Handler func(ctx context.Context, player *Player, msg *pb.Message)
+
+// 中间件, 包装 next 并返回新的处理器
+type Middleware func(next Handler) Handler
+
 // 消息管理器
 type MessageManager struct {
-	player_handlers map[pb.MessageId]func(player *Player, msg *pb.Message)
+	player_handlers map[pb.MessageId]Handler
+	middlewares     []Middleware                           // 所有消息的中间件
+	msgMiddlewares  map[pb.MessageId][]Middleware          // 单个消息的中间件, 在全局中间件之内执行
+	responses       map[pb.MessageId]pb.MessageId          // 请求 id -> 响应 id
+	responseTypes   map[pb.MessageId]protoreflect.FullName // 响应 id -> 响应消息类型
+	errs            []error                                // 注册时发现的问题, 由 Validate 统一返回
+	chains          map[pb.MessageId]Handler               // Build 生成的带中间件的处理器
+	forwardChain    Handler                                // 转发给房间逻辑的消息使用的处理器
+	unknownChain    Handler                                // 未定义的消息使用的处理器
 	//room_handlers   map[pb.MessageId]func(room *Room, roomMsg *RoomMessage)
 }
 
 // 初始化消息管理器
 func NewMessageManager() *MessageManager {
 	return &MessageManager{
-		player_handlers: make(map[pb.MessageId]func(player *Player, msg *pb.Message)),
+		player_handlers: make(map[pb.MessageId]Handler),
+		msgMiddlewares:  make(map[pb.MessageId][]Middleware),
+		responses:       make(map[pb.MessageId]pb.MessageId),
+		responseTypes:   make(map[pb.MessageId]protoreflect.FullName),
 		//room_handlers:   make(map[pb.MessageId]func(room *Room, roomMsg *RoomMessage)),
 	}
 }
 
 // 注册消息处理回调
-func (m *MessageManager) PlayerRegister(msgId pb.MessageId, handler func(player *Player, msg *pb.Message)) {
+func (m *MessageManager) PlayerRegister(msgId pb.MessageId, handler Handler) {
+	if _, ok := m.player_handlers[msgId]; ok {
+		m.errs = append(m.errs, fmt.Errorf("%s registered twice", msgId))
+	}
 	m.player_handlers[msgId] = handler
 }
 
+// 添加作用于所有消息的中间件, 先添加的在外层
+func (m *MessageManager) Use(middlewares ...Middleware) {
+	m.middlewares = append(m.middlewares, middlewares...)
+}
+
+// 添加只作用于 msgId 的中间件
+func (m *MessageManager) UseFor(msgId pb.MessageId, middlewares ...Middleware) {
+	m.msgMiddlewares[msgId] = append(m.msgMiddlewares[msgId], middlewares...)
+}
+
+// 为每个消息预先包装好中间件, 注册完所有处理器和中间件后调用一次
+func (m *MessageManager) Build() {
+	m.chains = make(map[pb.MessageId]Handler, len(m.player_handlers)+len(m.msgMiddlewares))
+	for msgId, handler := range m.player_handlers {
+		m.chains[msgId] = m.wrap(handler, m.msgMiddlewares[msgId])
+	}
+	// 只配置了中间件的消息也会转发给房间逻辑, 需要带上自己的中间件
+	for msgId := range m.msgMiddlewares {
+		if _, ok := m.chains[msgId]; !ok {
+			m.chains[msgId] = m.wrap(fallbackHandler(msgId), m.msgMiddlewares[msgId])
+		}
+	}
+	m.forwardChain = m.wrap(forwardToRoom, nil)
+	m.unknownChain = m.wrap(unknownMessage, nil)
+}
+
+// 没有注册处理器时, 已定义的消息转发给房间, 未定义的消息回复 UNKNOWN_MESSAGE
+func fallbackHandler(msgId pb.MessageId) Handler {
+	if _, defined := pb.MessageId_name[int32(msgId)]; !defined {
+		return unknownMessage
+	}
+	return forwardToRoom
+}
+
+// 用单个消息的中间件 chain 和全局中间件包装处理器, 第一个中间件在最外层
+func (m *MessageManager) wrap(handler Handler, chain []Middleware) Handler {
+	for i := len(chain) - 1; i >= 0; i-- {
+		handler = chain[i](handler)
+	}
+	for i := len(m.middlewares) - 1; i >= 0; i-- {
+		handler = m.middlewares[i](handler)
+	}
+	return handler
+}
+
+// 声明请求对应的响应 id 和响应类型
+func (m *MessageManager) registerResponse(reqId, rspId pb.MessageId, rspType protoreflect.FullName) {
+	if _, ok := pb.MessageId_name[int32(rspId)]; !ok {
+		m.errs = append(m.errs, fmt.Errorf("%s: response id %d not defined", reqId, rspId))
+	}
+	if rspId == reqId {
+		m.errs = append(m.errs, fmt.Errorf("%s: response id equals request id", reqId))
+	}
+	// 按命名约定, FOO_RESPONSE 的消息体是 FooResponse
+	if want := messageTypeName(rspId); string(rspType.Name()) != want {
+		m.errs = append(m.errs, fmt.Errorf("%s: response %s expects %s, got %s", reqId, rspId, want, rspType.Name()))
+	}
+	if old, ok := m.responseTypes[rspId]; ok && old != rspType {
+		m.errs = append(m.errs, fmt.Errorf("%s: response %s used with both %s and %s", reqId, rspId, old, rspType))
+	}
+	m.responses[reqId] = rspId
+	m.responseTypes[rspId] = rspType
+}
+
+// 把消息 id 名转换为对应的消息类型名, 例如 JOIN_ROOM_RESPONSE -> JoinRoomResponse
+func messageTypeName(msgId pb.MessageId) string {
+	var b strings.Builder
+	for _, word := range strings.Split(msgId.String(), "_") {
+		if word == "" {
+			continue
+		}
+		b.WriteString(word[:1])
+		b.WriteString(strings.ToLower(word[1:]))
+	}
+	return b.String()
+}
+
+// 请求对应的响应 id, 未声明响应的消息返回 false
+func (m *MessageManager) ResponseId(reqId pb.MessageId) (pb.MessageId, bool) {
+	rspId, ok := m.responses[reqId]
+	return rspId, ok
+}
+
+// 检查注册表: 响应 id 必须已定义、不能作为请求注册, 响应类型必须与响应 id 的命名对应且唯一
+func (m *MessageManager) Validate() error {
+	errs := append([]error(nil), m.errs...)
+	for reqId, rspId := range m.responses {
+		if _, ok := m.player_handlers[rspId]; ok {
+			errs = append(errs, fmt.Errorf("%s: response %s is also registered as a request", reqId, rspId))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// 注册带类型的请求处理器, 框架负责解析请求、序列化响应并回复客户端
+// 处理器返回 error 时, 响应有 ret 字段则回复只带错误码的响应, 否则回复 ERROR_RESPONSE
+// 返回 nil 响应且无错误时不回复
+func Register[Req, Resp proto.Message](m *MessageManager, msgId, rspId pb.MessageId, handler func(ctx context.Context, player *Player, req Req) (Resp, error)) {
+	var zero Resp
+	m.registerResponse(msgId, rspId, zero.ProtoReflect().Descriptor().FullName())
+	m.PlayerRegister(msgId, func(ctx context.Context, player *Player, msg *pb.Message) {
+		req, err := parseRequest[Req](player, msg)
+		if err != nil {
+			player.SendError(msg, pb.ErrorCode_INVALID_REQUEST, err.Error())
+			return
+		}
+
+		resp, err := handler(ctx, player, req)
+		if err != nil {
+			log.Printf("Player %s %s failed: %v", player.Id, msgId, err)
+			if rsp, ok := errorResponse(resp, ErrorCodeOf(err)); ok {
+				player.SendResponse(msg, mustMarshal(rsp))
+			} else {
+				player.SendError(msg, ErrorCodeOf(err), ErrorMessageOf(err))
+			}
+			return
+		}
+		if !resp.ProtoReflect().IsValid() {
+			return
+		}
+		player.SendResponse(msg, mustMarshal(resp))
+	})
+}
+
+// 注册没有响应的消息处理器, 出错时回复 ERROR_RESPONSE
+func RegisterOneWay[Req proto.Message](m *MessageManager, msgId pb.MessageId, handler func(ctx context.Context, player *Player, req Req) error) {
+	m.PlayerRegister(msgId, func(ctx context.Context, player *Player, msg *pb.Message) {
+		req, err := parseRequest[Req](player, msg)
+		if err != nil {
+			player.SendError(msg, pb.ErrorCode_INVALID_REQUEST, err.Error())
+			return
+		}
+		if err := handler(ctx, player, req); err != nil {
+			log.Printf("Player %s %s failed: %v", player.Id, msgId, err)
+			player.SendError(msg, ErrorCodeOf(err), ErrorMessageOf(err))
+		}
+	})
+}
+
+// 把 (*Player).HandleXxx 方法表达式适配为 ctx 在前的处理器
+func method[Req, Resp proto.Message](f func(*Player, context.Context, Req) (Resp, error)) func(context.Context, *Player, Req) (Resp, error) {
+	return func(ctx context.Context, player *Player, req Req) (Resp, error) {
+		return f(player, ctx, req)
+	}
+}
+
+func oneWayMethod[Req proto.Message](f func(*Player, context.Context, Req) error) func(context.Context, *Player, Req) error {
+	return func(ctx context.Context, player *Player, req Req) error {
+		return f(player, ctx, req)
+	}
+}
+
+// 按 Req 的类型解析消息体
+func parseRequest[Req proto.Message](player *Player, msg *pb.Message) (Req, error) {
+	var zero Req
+	req := zero.ProtoReflect().Type().New().Interface().(Req)
+	if err := proto.Unmarshal(msg.GetData(), req); err != nil {
+		log.Printf("Player %s sent invalid %s: %v", player.Id, msg.GetId(), err)
+		return zero, err
+	}
+	return req, nil
+}
+
+// 创建一个与 resp 同类型的空响应, 并把错误码写入其 ret 字段; 响应没有 ret 字段时返回 false
+func errorResponse[Resp proto.Message](resp Resp, code pb.ErrorCode) (proto.Message, bool) {
+	m := resp.ProtoReflect().Type().New()
+	field := m.Descriptor().Fields().ByName("ret")
+	if field == nil || field.Kind() != protoreflect.EnumKind {
+		return nil, false
+	}
+	m.Set(field, protoreflect.ValueOfEnum(protoreflect.EnumNumber(code)))
+	return m.Interface(), true
+}
+
 //// 注册消息处理回调
 //func (m *MessageManager) RoomRegister(msgId pb.MessageId, handler func(player *Room, roomMsg *RoomMessage)) {
 //	m.room_handlers[msgId] = handler
@@ -30,11 +232,41 @@ func (m *MessageManager) PlayerRegister(msgId pb.MessageId, handler func(player
 
 // 处理消息
 func (m *MessageManager) PlayerHandle(player *Player, msg *pb.Message) {
-	if handler, ok := m.player_handlers[msg.GetId()]; ok {
-		handler(player, msg)
+	handler, ok := m.chains[msg.GetId()]
+	if !ok {
+		handler = m.forwardChain
+		if _, defined := pb.MessageId_name[int32(msg.GetId())]; !defined {
+			handler = m.unknownChain
+		}
+	}
+	// 每个请求有独立的超时, 玩家退出时一并取消
+	ctx, cancel := context.WithTimeout(player.Context(), Config.RequestTimeout)
+	defer cancel()
+	handler(ctx, player, msg)
+}
+
+// 框架未处理的消息交给所在房间的 RoomLogic, 不在房间中时没有人能处理
+func forwardToRoom(ctx context.Context, player *Player, msg *pb.Message) {
+	if player.Room == nil {
+		unknownMessage(ctx, player, msg)
+		return
+	}
+	err := player.Room.Post(ctx, &Event{
+		Type:     EventRoomMessage,
+		PlayerId: player.Id,
+		Ctx:      player.Context(),
+		Payload:  msg,
+	})
+	if err != nil {
+		player.SendError(msg, ErrorCodeOf(err), ErrorMessageOf(err))
 	}
 }
 
+func unknownMessage(ctx context.Context, player *Player, msg *pb.Message) {
+	log.Printf("Player %s sent unknown message %d", player.Id, msg.GetId())
+	player.SendError(msg, pb.ErrorCode_UNKNOWN_MESSAGE, "")
+}
+
 //// 房间处理消息
 //func (m *MessageManager) RoomHandle(room *Room, roomMsg *RoomMessage) {
 //	if handler, ok := m.room_handlers[roomMsg.Message.Id]; ok {
@@ -45,14 +277,38 @@ func (m *MessageManager) PlayerHandle(player *Player, msg *pb.Message) {
 // 全局消息管理器实例
 var MsgHandler = NewMessageManager()
 
-// 注册所有消息回调
-func InitMessageHandlers() {
-	MsgHandler.PlayerRegister(pb.MessageId_GET_ROOM_LIST_REQUEST, (*Player).HandleGetRoomListRequest)
-	MsgHandler.PlayerRegister(pb.MessageId_CREATE_ROOM_REQUEST, (*Player).HandleCreateRoomRequest)
-	MsgHandler.PlayerRegister(pb.MessageId_MOVE_REQUEST, (*Player).HandleMoveRequest)
+// 注册所有消息回调, 注册表不一致时返回错误
+func InitMessageHandlers() error {
+	// Tracing 在最外层, 其他中间件(包括 Recover 的日志)都能拿到追踪 id
+	MsgHandler.Use(Tracing(), Recover(), Logging(), Metrics(MsgStats))
+	// 限流在登录检查之前, 未登录的客户端也不能绕过限流刷屏
+	if Config.MsgRate > 0 {
+		MsgHandler.Use(RateLimit(Config.MsgRate, Config.MsgBurst))
+	}
+	if Config.RequireLogin {
+		MsgHandler.Use(RequireLogin(pb.MessageId_LOGIN_REQUEST))
+	}
+	for msgId, limit := range Config.MsgRateLimits {
+		MsgHandler.UseFor(msgId, RateLimit(limit.Rate, limit.Burst))
+	}
+
+	Register(MsgHandler, pb.MessageId_LOGIN_REQUEST, pb.MessageId_LOGIN_RESPONSE, method((*Player).HandleLoginRequest))
+	Register(MsgHandler, pb.MessageId_GET_ROOM_LIST_REQUEST, pb.MessageId_GET_ROOM_LIST_RESPONSE, method((*Player).HandleGetRoomListRequest))
+	Register(MsgHandler, pb.MessageId_CREATE_ROOM_REQUEST, pb.MessageId_CREATE_ROOM_RESPONSE, method((*Player).HandleCreateRoomRequest))
+	RegisterOneWay(MsgHandler, pb.MessageId_MOVE_REQUEST, oneWayMethod((*Player).HandleMoveRequest))
 
-	MsgHandler.PlayerRegister(pb.MessageId_JOIN_ROOM_REQUEST, (*Player).HandleJoinRoomRequest)
+	Register(MsgHandler, pb.MessageId_JOIN_ROOM_REQUEST, pb.MessageId_JOIN_ROOM_RESPONSE, method((*Player).HandleJoinRoomRequest))
+	Register(MsgHandler, pb.MessageId_SWITCH_TEAM_REQUEST, pb.MessageId_SWITCH_TEAM_RESPONSE, method((*Player).HandleSwitchTeamRequest))
+	Register(MsgHandler, pb.MessageId_CHAT_REQUEST, pb.MessageId_CHAT_RESPONSE, method((*Player).HandleChatRequest))
+	RegisterOneWay(MsgHandler, pb.MessageId_STATE_ACK, oneWayMethod((*Player).HandleStateAck))
+	RegisterOneWay(MsgHandler, pb.MessageId_FRAME_INPUT_REQUEST, oneWayMethod((*Player).HandleFrameInputRequest))
+	Register(MsgHandler, pb.MessageId_FRAME_HISTORY_REQUEST, pb.MessageId_FRAME_HISTORY_RESPONSE, method((*Player).HandleFrameHistoryRequest))
+	RegisterOneWay(MsgHandler, pb.MessageId_PLAYER_INPUT_REQUEST, oneWayMethod((*Player).HandlePlayerInputRequest))
+	RegisterOneWay(MsgHandler, pb.MessageId_ROOM_CUSTOM_MESSAGE, oneWayMethod((*Player).HandleRoomCustomMessage))
 
 	//MsgHandler.RoomRegister(pb.MessageId_JOIN_ROOM_REQUEST, (*Room).JoinRoomRequest)
 	//MsgHandler.PlayerRegister(pb.MessageId_MOVE_REQUEST, (*Player).HandleMoveRequest)
+
+	MsgHandler.Build()
+	return MsgHandler.Validate()
 }
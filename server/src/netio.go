@@ -0,0 +1,127 @@
+package main
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"log"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+	pb "server/src/proto"
+)
+
+const (
+	readBufSize     = 4096      // 每次 Read 使用的临时缓冲区大小
+	maxPooledBufCap = 64 * 1024 // 超过该容量的写缓冲区不放回池中, 避免长期占用内存
+)
+
+// 读临时缓冲区池
+var readBufPool = sync.Pool{
+	New: func() interface{} {
+		buf := make([]byte, readBufSize)
+		return &buf
+	},
+}
+
+// 写缓冲区池, 一次发送的所有消息编码到同一个缓冲区
+var writeBufPool = sync.Pool{
+	New: func() interface{} {
+		buf := make([]byte, 0, readBufSize)
+		return &buf
+	},
+}
+
+// 已编码的消息帧(含长度前缀), 创建后只读, 广播时在所有接收者之间共享
+type Frame struct {
+	Id   pb.MessageId
+	data []byte
+}
+
+// 编码一次消息, 得到可以发给多个玩家的帧
+func EncodeFrame(msg *pb.Message) (*Frame, error) {
+	data, err := appendFrame(nil, msg)
+	if err != nil {
+		return nil, err
+	}
+	return &Frame{Id: msg.GetId(), data: data}, nil
+}
+
+// 把消息编码为 4 字节小端长度 + protobuf 数据, 追加到 buf
+func appendFrame(buf []byte, msg *pb.Message) ([]byte, error) {
+	start := len(buf)
+	buf = append(buf, 0, 0, 0, 0)
+	buf, err := proto.MarshalOptions{}.MarshalAppend(buf, msg)
+	if err != nil {
+		return buf[:start], err
+	}
+	binary.LittleEndian.PutUint32(buf[start:], uint32(len(buf)-start-4))
+	return buf, nil
+}
+
+// 把 items 编码到一个缓冲区后一次写出, 已编码的帧直接复制, 编码失败的消息被跳过
+func writeFrames(w io.Writer, items []outboundItem) error {
+	bufPtr := writeBufPool.Get().(*[]byte)
+	buf := (*bufPtr)[:0]
+	for _, item := range items {
+		if item.frame != nil {
+			buf = append(buf, item.frame.data...)
+			continue
+		}
+		var err error
+		if buf, err = appendFrame(buf, item.msg); err != nil {
+			log.Println("Failed to marshal response:", err)
+		}
+	}
+
+	var err error
+	if len(buf) > 0 {
+		_, err = w.Write(buf)
+	}
+	if cap(buf) <= maxPooledBufCap {
+		*bufPtr = buf
+		writeBufPool.Put(bufPtr)
+	}
+	return err
+}
+
+// 从 r 持续读取消息帧, 每解析出一条消息调用一次 handle, 读出错时返回该错误
+// 帧长度超过 Config.MaxFrameSize 时返回错误, 避免恶意的长度前缀让缓冲区无限增长
+func readFrames(r io.Reader, handle func(msg *pb.Message)) error {
+	bufPtr := readBufPool.Get().(*[]byte)
+	defer readBufPool.Put(bufPtr)
+	tempBuf := *bufPtr
+
+	buffer := make([]byte, 0, readBufSize)
+	for {
+		n, err := r.Read(tempBuf)
+		if n > 0 {
+			buffer = append(buffer, tempBuf[:n]...)
+
+			offset := 0
+			for len(buffer)-offset >= 4 {
+				length := int(binary.LittleEndian.Uint32(buffer[offset:]))
+				if length > Config.MaxFrameSize {
+					return fmt.Errorf("frame of %d bytes exceeds limit %d", length, Config.MaxFrameSize)
+				}
+				if len(buffer)-offset < 4+length {
+					break // Not enough data for the full packet
+				}
+				messageBuf := buffer[offset+4 : offset+4+length]
+				offset += 4 + length
+
+				msg := &pb.Message{}
+				if err := proto.Unmarshal(messageBuf, msg); err != nil {
+					log.Println("Invalid message:", err)
+					continue
+				}
+				handle(msg)
+			}
+			// 把未解析完的数据移到开头, 复用同一块内存
+			buffer = buffer[:copy(buffer, buffer[offset:])]
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
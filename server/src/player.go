@@ -1,12 +1,14 @@
 package main
 
 import (
-	"encoding/binary"
-	"google.golang.org/protobuf/proto"
+	"context"
 	"log"
 	"net"
+	"net/netip"
 	pb "server/src/proto"
 	"sync"
+	"sync/atomic"
+	"time"
 )
 
 //player 与room 之间的映射关系
@@ -17,28 +19,69 @@ type Player struct {
 	Name     string
 	Position *pb.Position
 	Room     *Room
+	TeamId   int32 // 房间内所在队伍
 	Conn     net.Conn
+	Addr     netip.Addr       // 客户端真实 IP, 经过代理时来自 PROXY 头
 	RecvChan chan *pb.Message // 玩家收消息管道
-	SendChan chan *pb.Message // 玩家发消息管道
-	QuitChan chan bool        // 退出信号
+	outbox   *SendQueue       // 玩家发消息队列
+	writing  int32            // 发送协程正在写出一批消息, 原子访问
+	QuitChan chan bool        // 玩家退出时关闭
+
+	ctx      context.Context // 玩家退出时取消, 所有请求的 ctx 都派生自它
+	cancel   context.CancelFunc
+	quitOnce sync.Once
+
+	// 移动校验状态, 只在房间协程中访问
+	lastValidPos   *pb.Position // 上一次被接受的位置
+	lastMoveAt     time.Time    // 上一次被接受的移动的服务器时间
+	moveViolations int          // 累计被拒绝的移动次数
+
+	kinematic kinematicState // 权威模拟状态, 只在房间协程中访问
+
+	// 中间件状态, 只在消息处理协程中访问
+	loggedIn            bool
+	rateLimiters        map[*rateLimitKey]*TokenBucket
+	rateViolations      int       // 当前窗口内的超限次数
+	rateViolationsSince time.Time // 当前窗口的开始时间
 }
 
+// 踢出玩家前等待通知发出的时间
+const kickFlushDelay = 500 * time.Millisecond
+
 // NewPlayer 创建玩家
 func NewPlayer(id string, conn net.Conn) *Player {
-
+	ctx, cancel := context.WithCancel(context.Background())
 	return &Player{
 		Id:       id,
 		Name:     "",
 		Position: &pb.Position{X: 0, Y: 0, Z: 0},
 
 		Conn: conn,
+		Addr: remoteAddr(conn),
 
 		RecvChan: make(chan *pb.Message, 1000),
-		SendChan: make(chan *pb.Message, 1000),
+		outbox:   NewSendQueue(Config.SendQueueSize, Config.SendBacklog),
 		QuitChan: make(chan bool),
+
+		ctx:    ctx,
+		cancel: cancel,
 	}
 }
 
+// 玩家的 ctx, 玩家退出后被取消
+func (p *Player) Context() context.Context {
+	return p.ctx
+}
+
+// 通知玩家退出: 取消 ctx 并关闭连接, 让所有协程结束, 可重复调用
+func (p *Player) Quit() {
+	p.quitOnce.Do(func() {
+		p.cancel()
+		close(p.QuitChan)
+		p.Conn.Close()
+	})
+}
+
 // 启动玩家逻辑协程
 func (p *Player) Run() {
 	var wg sync.WaitGroup
@@ -46,21 +89,21 @@ func (p *Player) Run() {
 
 	defer func() {
 		// Clean up when the player exits
-		leaveRoom := &Event{
-			Type:     EventLeaveRoom,
-			PlayerId: p.Id,
-		}
+		// 玩家的 ctx 已取消, 离开房间使用单独的超时
 		if p.Room != nil {
-			p.Room.EventChan <- leaveRoom
+			ctx, cancel := context.WithTimeout(context.Background(), Config.RequestTimeout)
+			leaveRoom := &Event{
+				Type:     EventLeaveRoom,
+				PlayerId: p.Id,
+			}
 
 			// Wait for the room to process the leave event
-			<-leaveRoom.ResponseChan
+			if _, err := RoomRequest[*pb.LeaveRoomResponse](ctx, p.Room, leaveRoom); err != nil {
+				log.Printf("Player %s failed to leave room %s: %v", p.Id, p.Room.Name, err)
+			}
+			cancel()
 		}
-		close(p.RecvChan)
-		close(p.SendChan)
-		close(p.QuitChan)
-		defer p.Conn.Close()
-		defer GlobalManager.DeletePlayer(p.Id)
+		GlobalManager.DeletePlayer(p.Id)
 
 		log.Printf("Player %s exited", p.Id)
 	}()
@@ -68,83 +111,38 @@ func (p *Player) Run() {
 	// Goroutine to handle incoming messages
 	go func() {
 		defer wg.Done()
-		buffer := make([]byte, 0, 4096) // Initial buffer
-		for {
-			log.Println("Waiting to read from connection...")
-
-			// Temporary buffer
-			tempBuf := make([]byte, 1024)
-			n, err := p.Conn.Read(tempBuf)
-			if err != nil {
-				log.Println("Connection closed:", err)
-				p.QuitChan <- true
-				return
-			}
-
-			// Append read data to buffer
-			buffer = append(buffer, tempBuf[:n]...)
-
-			// Process data in buffer
-			for {
-				log.Printf("Buffer length: %d, Buffer content: %v", len(buffer), buffer)
-				// Check if there is enough data to read the packet length
-				if len(buffer) < 4 {
-					log.Println("Not enough data to read the packet length, breaking out of inner loop")
-					break
-				}
-
-				// Read packet length
-				length := int(binary.LittleEndian.Uint32(buffer[:4]))
-
-				// Check if there is enough data to read the full packet
-				if len(buffer) < 4+length {
-					break // Not enough data for the full packet
-				}
-
-				// Read the full packet
-				messageBuf := buffer[4 : 4+length]
-				buffer = buffer[4+length:] // Remove processed data
-
-				var parsedMsg pb.Message
-				if err := proto.Unmarshal(messageBuf, &parsedMsg); err != nil {
-					log.Println("Invalid message:", err)
-					continue
-				}
-
-				// Attempt to send the message to RecvChan
-				select {
-				case p.RecvChan <- &parsedMsg:
-					// Successfully enqueued
-				default:
-					// Drop the message if the channel is full
-					log.Println("RecvChan full, dropping message")
-				}
+		err := readFrames(p.Conn, func(msg *pb.Message) {
+			// Attempt to send the message to RecvChan
+			select {
+			case p.RecvChan <- msg:
+				// Successfully enqueued
+			default:
+				// Drop the message if the channel is full
+				log.Println("RecvChan full, dropping message")
 			}
-		}
+		})
+		log.Println("Connection closed:", err)
+		p.Quit()
 	}()
 
-	// 处理发送消息的协程
+	// 处理发送消息的协程, 每次取出队列中的所有消息合并为一次写
 	go func() {
 		defer wg.Done()
+		var pending []outboundItem
 		for {
 			select {
-			case rspMsg := <-p.SendChan:
-				data, err := proto.Marshal(rspMsg)
+			case <-p.outbox.Ready():
+				atomic.StoreInt32(&p.writing, 1)
+				pending = p.outbox.PopAll(pending[:0])
+				err := writeFrames(p.Conn, pending)
+				clear(pending)
+				atomic.StoreInt32(&p.writing, 0)
 				if err != nil {
-					log.Println("Failed to marshal response:", err)
-					continue
-				}
-
-				length := make([]byte, 4)
-				binary.LittleEndian.PutUint32(length, uint32(len(data)))
-
-				packet := append(length, data...)
-				if _, err := p.Conn.Write(packet); err != nil {
 					log.Println("Failed to write response:", err)
-					p.QuitChan <- true
+					p.Quit()
 					return
 				}
-			case <-p.QuitChan:
+			case <-p.ctx.Done():
 				return
 			}
 		}
@@ -155,11 +153,9 @@ func (p *Player) Run() {
 		defer wg.Done()
 		for {
 			select {
-			case <-p.QuitChan:
+			case <-p.ctx.Done():
 				return
 			case msg := <-p.RecvChan:
-
-				log.Printf("Received message: %v", msg)
 				// Process the message (e.g., handle requests)
 				MsgHandler.PlayerHandle(p, msg)
 			}
@@ -169,131 +165,376 @@ func (p *Player) Run() {
 	wg.Wait() // Wait for all goroutines to finish
 }
 
+// 转换为协议中的玩家信息
+func (p *Player) ToProto() *pb.Player {
+	return &pb.Player{
+		Id:           p.Id,
+		Name:         p.Name,
+		Position:     p.Position,
+		TeamId:       p.TeamId,
+		LastInputSeq: p.kinematic.lastInputSeq,
+	}
+}
+
+// 通知客户端后断开连接, 连接关闭后读协程退出并走正常的清理流程
+func (p *Player) Kick(reason string) {
+	log.Printf("Kicking player %s (%s): %s", p.Id, p.Addr, reason)
+	p.SendMessage(&pb.Message{
+		Id:          pb.MessageId_KICK_NOTIFICATION,
+		MsgSerialNo: -1,
+		ClientId:    "",
+		Data:        mustMarshal(&pb.KickNotification{Reason: reason}),
+	})
+	time.AfterFunc(kickFlushDelay, func() {
+		p.Conn.Close()
+	})
+}
+
+// 按消息 id 对应的优先级发送消息
 func (p *Player) SendMessage(msg *pb.Message) {
-	p.SendChan <- msg
+	p.SendMessageWithPriority(msg, priorityOf(msg.GetId()))
+}
+
+// 发送消息, 不会阻塞
+func (p *Player) SendMessageWithPriority(msg *pb.Message, priority SendPriority) {
+	p.enqueue(outboundItem{msg: msg}, priority)
+}
+
+// 发送已编码的帧, 帧可以同时发给多个玩家
+func (p *Player) SendFrame(frame *Frame) {
+	p.enqueue(outboundItem{frame: frame}, priorityOf(frame.Id))
+}
+
+// 等待发送队列中的消息全部写出, 截止时间到或玩家已退出时返回 false
+func (p *Player) Flush(deadline time.Time) bool {
+	p.Conn.SetWriteDeadline(deadline)
+	for p.outbox.Len() > 0 || atomic.LoadInt32(&p.writing) == 1 {
+		if p.ctx.Err() != nil || time.Now().After(deadline) {
+			return false
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return true
+}
+
+// 入队, 队列持续积压超过 Config.SendBacklogTimeout 时断开玩家
+func (p *Player) enqueue(item outboundItem, priority SendPriority) {
+	if p.ctx.Err() != nil {
+		return
+	}
+	backlog := p.outbox.Push(item, priority)
+	if Config.SendBacklogTimeout > 0 && backlog > Config.SendBacklogTimeout {
+		log.Printf("Player %s send queue backlogged for %v (%d queued, %d dropped), disconnecting",
+			p.Id, backlog, p.outbox.Len(), p.outbox.Dropped())
+		atomic.AddUint64(&OutboundStats.SlowDisconnects, 1)
+		p.Quit()
+	}
 }
 
 func (p *Player) SendResponse(srcMsg *pb.Message, responseData []byte) {
+	rspId, ok := MsgHandler.ResponseId(srcMsg.GetId())
+	if !ok {
+		log.Printf("No response registered for %s, dropped", srcMsg.GetId())
+		return
+	}
 
 	// 响应
 	response := &pb.Message{
-		Id:          srcMsg.GetId() + 1,      // Response ID is request ID + 1
+		Id:          rspId,
 		MsgSerialNo: srcMsg.GetMsgSerialNo(), // Use the same message serial number
 		ClientId:    srcMsg.GetClientId(),    // Use the same client ID
 		Data:        responseData,
 	}
 
 	log.Printf("SendResponse: src: %v, rsp: %v ", srcMsg, response)
-	p.SendMessage(response)
+	p.SendMessageWithPriority(response, PriorityResponse)
+}
+
+// 请求无法被正常处理时回复通用错误响应
+func (p *Player) SendError(srcMsg *pb.Message, code pb.ErrorCode, message string) {
+	p.SendErrorWithPriority(srcMsg, code, message, PriorityResponse)
+}
+
+// 按指定优先级回复错误, 用于客户端刷屏时可以丢弃的错误
+func (p *Player) SendErrorWithPriority(srcMsg *pb.Message, code pb.ErrorCode, message string, priority SendPriority) {
+	p.SendMessageWithPriority(&pb.Message{
+		Id:          pb.MessageId_ERROR_RESPONSE,
+		MsgSerialNo: srcMsg.GetMsgSerialNo(),
+		ClientId:    srcMsg.GetClientId(),
+		Data: mustMarshal(&pb.ErrorResponse{
+			MsgSerialNo: srcMsg.GetMsgSerialNo(),
+			RequestId:   srcMsg.GetId(),
+			Code:        code,
+			Message:     message,
+		}),
+	}, priority)
 }
 
 // HandleMoveRequest 处理移动请求
-func (p *Player) HandleMoveRequest(msg *pb.Message) {
-	var req pb.MoveRequest
-	if err := proto.Unmarshal(msg.GetData(), &req); err != nil {
-		log.Println("Failed to parse MoveRequest:", err)
-		return
+func (p *Player) HandleMoveRequest(ctx context.Context, req *pb.MoveRequest) error {
+	if p.Room == nil {
+		return NewGameError(pb.ErrorCode_PLAYER_NOT_IN_ROOM, "")
+	}
+	// 帧同步房间只接受 FrameInputRequest, 房间模式创建后不再改变
+	if p.Room.IsLockstep() {
+		return NewGameError(pb.ErrorCode_ROOM_MODE_MISMATCH, "move in lockstep room %s", p.Room.Name)
+	}
+	// 权威模拟房间由服务器计算位置, 只接受 PlayerInputRequest
+	if p.Room.Authoritative {
+		return NewGameError(pb.ErrorCode_ROOM_MODE_MISMATCH, "move in authoritative room %s", p.Room.Name)
 	}
-	p.Position = req.Position
-	log.Printf("Player %s moved to: %+v", p.Name, p.Position)
+	// 玩家身份以连接为准, 忽略客户端填写的 playerId
+	if req.PlayerId != "" && req.PlayerId != p.Id {
+		log.Printf("Player %s sent MoveRequest for player %s, ignored playerId", p.Id, req.PlayerId)
+	}
+	log.Printf("Player %s move request: %+v", p.Name, req.Position)
 
 	moveEvent := &Event{
 		Type:     EventMove,
 		PlayerId: p.Id,
+		Ctx:      p.ctx,
 		Payload:  req,
 	}
-	p.Room.EventChan <- moveEvent
+	return p.Room.Post(ctx, moveEvent)
 }
 
-func (p *Player) HandleLoginRequest(msg *pb.Message) {
-	var req pb.LoginRequest
-	if err := proto.Unmarshal(msg.GetData(), &req); err != nil {
-		log.Println("Failed to parse Login Request:", err)
-		return
+func (p *Player) HandleLoginRequest(ctx context.Context, req *pb.LoginRequest) (*pb.LoginResponse, error) {
+	p.loggedIn = true
+	return &pb.LoginResponse{
+		PlayerId: p.Id,
+	}, nil
+}
+
+// HandleJoinRoomRequest 处理加入房间请求
+func (p *Player) HandleJoinRoomRequest(ctx context.Context, req *pb.JoinRoomRequest) (*pb.JoinRoomResponse, error) {
+	if p.Room != nil {
+		return nil, NewGameError(pb.ErrorCode_PLAYER_ALREADY_IN_ROOM, "already in room %s", p.Room.Name)
+	}
+	room, ok := GlobalManager.GetRoom(req.RoomId)
+	if !ok {
+		return nil, NewGameError(pb.ErrorCode_ROOM_NOT_FOUND, "room %d", req.RoomId)
 	}
 
-	p.SendResponse(msg, mustMarshal(&pb.LoginResponse{
+	joinRoomEvent := &Event{
+		Type:     EventJoinRoom,
 		PlayerId: p.Id,
-	}))
+		Payload:  req,
+	}
+	response, err := p.requestJoin(ctx, room, joinRoomEvent)
+	if err != nil {
+		return nil, err
+	}
+	if response.Ret == pb.ErrorCode_OK {
+		p.Room = room
+	}
+	log.Printf("Player %s joined room: %s , ret: %d ", p.Name, room.Name, response.Ret)
+	return response, nil
 }
 
-// HandleMoveRequest 处理移动请求
-func (p *Player) HandleJoinRoomRequest(msg *pb.Message) {
-	var req pb.JoinRoomRequest
-	if err := proto.Unmarshal(msg.GetData(), &req); err != nil {
-		log.Println("Failed to parse JoinRoomReq:", err)
-		return
+// 请求加入房间; 超时放弃时房间可能已经加入了玩家, 补发一个离开事件保持两边一致
+func (p *Player) requestJoin(ctx context.Context, room *Room, event *Event) (*pb.JoinRoomResponse, error) {
+	response, err := RoomRequest[*pb.JoinRoomResponse](ctx, room, event)
+	if err != nil && ctx.Err() != nil {
+		// 确认离开之前仍视为在房间中, 离开失败时玩家退出时会再次离开
+		p.Room = room
+		leaveCtx, cancel := context.WithTimeout(context.Background(), Config.RequestTimeout)
+		defer cancel()
+		leaveRoom := &Event{
+			Type:     EventLeaveRoom,
+			PlayerId: p.Id,
+		}
+		if _, leaveErr := RoomRequest[*pb.LeaveRoomResponse](leaveCtx, room, leaveRoom); leaveErr != nil {
+			log.Printf("Player %s failed to leave room %s after join timeout: %v", p.Id, room.Name, leaveErr)
+		} else {
+			p.Room = nil
+		}
 	}
+	return response, err
+}
 
-	result := pb.ErrorCode_OK
+func (p *Player) HandleGetRoomListRequest(ctx context.Context, req *pb.GetRoomListRequest) (*pb.GetRoomListResponse, error) {
+	return &pb.GetRoomListResponse{
+		Ret:   pb.ErrorCode_OK,
+		Rooms: RoomsToProto(GlobalManager.GetAllRooms()),
+	}, nil
+}
 
+func (p *Player) HandleCreateRoomRequest(ctx context.Context, req *pb.CreateRoomRequest) (*pb.CreateRoomResponse, error) {
 	if p.Room != nil {
-		log.Printf("Player %s already in room %s", p.Name, p.Room.Name)
-		result = pb.ErrorCode_PLAYER_ALREADY_IN_ROOM
-		goto sendResponse
-	} else {
-		room, ok := GlobalManager.GetRoom(req.RoomId)
-		if !ok {
-			log.Printf("Room %s not found", req.RoomId)
-			result = pb.ErrorCode_ROOM_NOT_FOUND
-			goto sendResponse
-		}
-		joinRoomEvent := &Event{
-			Type:     EventJoinRoom,
-			PlayerId: p.Id,
-			Payload:  req,
-		}
-		room.EventChan <- joinRoomEvent
+		return nil, NewGameError(pb.ErrorCode_PLAYER_ALREADY_IN_ROOM, "already in room %s", p.Room.Name)
+	}
 
-		response := <-joinRoomEvent.ResponseChan
-		if response.(*pb.JoinRoomResponse).Ret == pb.ErrorCode_OK {
-			p.Room = room
+	if req.TeamCount < 0 || req.TeamCount > MaxTeamCount || req.TeamSize < 0 || req.TeamSize > MaxTeamSize {
+		return nil, NewGameError(pb.ErrorCode_INVALID_REQUEST, "team count %d, team size %d", req.TeamCount, req.TeamSize)
+	}
+
+	// 先校验创建者的队伍, 避免加入失败后留下一个空房间
+	if req.TeamId != NoTeam && (req.TeamId < 1 || req.TeamId > req.TeamCount) {
+		return nil, NewGameError(pb.ErrorCode_TEAM_NOT_FOUND, "team %d", req.TeamId)
+	}
+
+	if !RoomLogics.Has(req.RoomType) {
+		return nil, NewGameError(pb.ErrorCode_ROOM_TYPE_NOT_FOUND, "room type %s", req.RoomType)
+	}
+
+	var gameMap *GameMap
+	if req.Authoritative {
+		m, err := LoadGameMap(req.MapName)
+		if err != nil {
+			return nil, &GameError{Code: pb.ErrorCode_MAP_NOT_FOUND, Message: err.Error()}
 		}
-		log.Printf("Player %s joined room: %s , ret: %d ", p.Name, room.Name, response.(*pb.JoinRoomResponse).Ret)
-		p.SendResponse(msg, mustMarshal(response.(*pb.JoinRoomResponse)))
-		return
+		gameMap = m
+	}
+
+	room := GlobalManager.GetOrCreateRoom(IncrementAndGetRoomCounter(), req.Name, RoomConfig{
+		TeamCount:     req.TeamCount,
+		TeamSize:      req.TeamSize,
+		TickRate:      req.TickRate,
+		DeltaSync:     req.DeltaSync,
+		AoiRadius:     req.AoiRadius,
+		Mode:          req.Mode,
+		InputDelay:    req.InputDelay,
+		Authoritative: req.Authoritative,
+		Map:           gameMap,
+		RoomType:      req.RoomType,
+	})
+
+	// 创建者和其他玩家一样通过房间协程加入, 保证 RoomLogic 回调都在房间协程中执行
+	joinRoomEvent := &Event{
+		Type:     EventJoinRoom,
+		PlayerId: p.Id,
+		Payload: &pb.JoinRoomRequest{
+			RoomId: room.ID,
+			TeamId: req.TeamId,
+		},
 	}
 
-sendResponse:
-	p.SendResponse(msg, mustMarshal(&pb.JoinRoomResponse{
-		Ret:  result,
-		Room: &pb.Room{Id: p.Room.ID, Name: p.Room.Name},
-	}))
+	response, err := p.requestJoin(ctx, room, joinRoomEvent)
+	if err != nil || response.Ret != pb.ErrorCode_OK {
+		// 创建者没能加入, 房间不应该继续留在列表中
+		GlobalManager.DeleteRoom(room.ID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if response.Ret == pb.ErrorCode_OK {
+		p.Room = room
+	}
+	return &pb.CreateRoomResponse{
+		Ret:  response.Ret,
+		Room: response.Room,
+	}, nil
 }
 
-func (p *Player) HandleGetRoomListRequest(msg *pb.Message) {
+// HandleSwitchTeamRequest 处理切换队伍请求
+func (p *Player) HandleSwitchTeamRequest(ctx context.Context, req *pb.SwitchTeamRequest) (*pb.SwitchTeamResponse, error) {
+	if p.Room == nil {
+		return nil, NewGameError(pb.ErrorCode_PLAYER_NOT_IN_ROOM, "")
+	}
 
-	var req pb.GetRoomListRequest
-	if err := proto.Unmarshal(msg.GetData(), &req); err != nil {
-		log.Println("Failed to parse GetRoomListRequest:", err)
-		return
+	switchTeamEvent := &Event{
+		Type:     EventSwitchTeam,
+		PlayerId: p.Id,
+		Payload:  req,
 	}
+	return RoomRequest[*pb.SwitchTeamResponse](ctx, p.Room, switchTeamEvent)
+}
 
-	p.SendResponse(msg, mustMarshal(&pb.GetRoomListResponse{
-		Ret:   0,
-		Rooms: RoomsToProto(GlobalManager.GetAllRooms()),
-	}))
+// HandleChatRequest 处理聊天请求, 由房间协程转发给房间或队伍内的玩家
+func (p *Player) HandleChatRequest(ctx context.Context, req *pb.ChatRequest) (*pb.ChatResponse, error) {
+	if p.Room == nil {
+		return nil, NewGameError(pb.ErrorCode_PLAYER_NOT_IN_ROOM, "")
+	}
 
+	err := p.Room.Post(ctx, &Event{
+		Type:     EventChat,
+		PlayerId: p.Id,
+		Ctx:      p.ctx,
+		Payload:  req,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ChatResponse{
+		Ret: pb.ErrorCode_OK,
+	}, nil
 }
 
-func (p *Player) HandleCreateRoomRequest(msg *pb.Message) {
-	var req pb.CreateRoomRequest
-	if err := proto.Unmarshal(msg.GetData(), &req); err != nil {
-		log.Println("Failed to parse CreateRoomRequest:", err)
-		return
+// HandleStateAck 转发客户端的快照确认到房间协程
+func (p *Player) HandleStateAck(ctx context.Context, req *pb.StateAck) error {
+	if p.Room == nil {
+		return NewGameError(pb.ErrorCode_PLAYER_NOT_IN_ROOM, "")
 	}
 
-	if p.Room != nil {
-		log.Printf("Player %s already in room %s", p.Name, p.Room.Name)
-		return
+	return p.Room.Post(ctx, &Event{
+		Type:     EventStateAck,
+		PlayerId: p.Id,
+		Ctx:      p.ctx,
+		Payload:  req,
+	})
+}
+
+// HandleFrameInputRequest 转发帧同步输入到房间协程
+func (p *Player) HandleFrameInputRequest(ctx context.Context, req *pb.FrameInputRequest) error {
+	if p.Room == nil {
+		return NewGameError(pb.ErrorCode_PLAYER_NOT_IN_ROOM, "")
+	}
+	if !p.Room.IsLockstep() {
+		return NewGameError(pb.ErrorCode_ROOM_MODE_MISMATCH, "frame input in non-lockstep room %s", p.Room.Name)
+	}
+
+	return p.Room.Post(ctx, &Event{
+		Type:     EventFrameInput,
+		PlayerId: p.Id,
+		Ctx:      p.ctx,
+		Payload:  req,
+	})
+}
+
+// HandleFrameHistoryRequest 拉取帧同步历史帧
+func (p *Player) HandleFrameHistoryRequest(ctx context.Context, req *pb.FrameHistoryRequest) (*pb.FrameHistoryResponse, error) {
+	if p.Room == nil {
+		return nil, NewGameError(pb.ErrorCode_PLAYER_NOT_IN_ROOM, "")
 	}
 
-	room := GlobalManager.GetOrCreateRoom(IncrementAndGetRoomCounter(), req.Name)
-	room.AddPlayer(p)
+	frameHistoryEvent := &Event{
+		Type:     EventFrameHistory,
+		PlayerId: p.Id,
+		Payload:  req,
+	}
+	return RoomRequest[*pb.FrameHistoryResponse](ctx, p.Room, frameHistoryEvent)
+}
 
-	p.SendResponse(msg, mustMarshal(&pb.CreateRoomResponse{
-		Ret:  0,
-		Room: room.FillRoomMsg(),
-	}))
+// HandlePlayerInputRequest 转发权威模拟输入到房间协程
+func (p *Player) HandlePlayerInputRequest(ctx context.Context, req *pb.PlayerInputRequest) error {
+	if p.Room == nil {
+		return NewGameError(pb.ErrorCode_PLAYER_NOT_IN_ROOM, "")
+	}
+	if !p.Room.Authoritative {
+		return NewGameError(pb.ErrorCode_ROOM_MODE_MISMATCH, "player input in non-authoritative room %s", p.Room.Name)
+	}
 
+	return p.Room.Post(ctx, &Event{
+		Type:     EventPlayerInput,
+		PlayerId: p.Id,
+		Ctx:      p.ctx,
+		Payload:  req,
+	})
+}
+
+// HandleRoomCustomMessage 转发自定义消息到房间协程
+func (p *Player) HandleRoomCustomMessage(ctx context.Context, req *pb.RoomCustomMessage) error {
+	if len(req.Data) > MaxCustomMessageSize {
+		return NewGameError(pb.ErrorCode_INVALID_REQUEST, "oversized custom message (%d bytes)", len(req.Data))
+	}
+	if p.Room == nil {
+		return NewGameError(pb.ErrorCode_PLAYER_NOT_IN_ROOM, "")
+	}
+
+	return p.Room.Post(ctx, &Event{
+		Type:     EventCustomMessage,
+		PlayerId: p.Id,
+		Ctx:      p.ctx,
+		Payload:  req,
+	})
 }
@@ -20,6 +20,262 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type EntityType int32
+
+const (
+	EntityType_ENTITY_UNKNOWN    EntityType = 0
+	EntityType_ENTITY_NPC        EntityType = 1
+	EntityType_ENTITY_PROJECTILE EntityType = 2
+	EntityType_ENTITY_PICKUP     EntityType = 3
+)
+
+// Enum value maps for EntityType.
+var (
+	EntityType_name = map[int32]string{
+		0: "ENTITY_UNKNOWN",
+		1: "ENTITY_NPC",
+		2: "ENTITY_PROJECTILE",
+		3: "ENTITY_PICKUP",
+	}
+	EntityType_value = map[string]int32{
+		"ENTITY_UNKNOWN":    0,
+		"ENTITY_NPC":        1,
+		"ENTITY_PROJECTILE": 2,
+		"ENTITY_PICKUP":     3,
+	}
+)
+
+func (x EntityType) Enum() *EntityType {
+	p := new(EntityType)
+	*p = x
+	return p
+}
+
+func (x EntityType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EntityType) Descriptor() protoreflect.EnumDescriptor {
+	return file_game_proto_enumTypes[0].Descriptor()
+}
+
+func (EntityType) Type() protoreflect.EnumType {
+	return &file_game_proto_enumTypes[0]
+}
+
+func (x EntityType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EntityType.Descriptor instead.
+func (EntityType) EnumDescriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{0}
+}
+
+type RoomMode int32
+
+const (
+	RoomMode_MODE_STATE_SYNC RoomMode = 0 // 状态同步
+	RoomMode_MODE_LOCKSTEP   RoomMode = 1 // 帧同步, 服务器只转发每帧输入
+)
+
+// Enum value maps for RoomMode.
+var (
+	RoomMode_name = map[int32]string{
+		0: "MODE_STATE_SYNC",
+		1: "MODE_LOCKSTEP",
+	}
+	RoomMode_value = map[string]int32{
+		"MODE_STATE_SYNC": 0,
+		"MODE_LOCKSTEP":   1,
+	}
+)
+
+func (x RoomMode) Enum() *RoomMode {
+	p := new(RoomMode)
+	*p = x
+	return p
+}
+
+func (x RoomMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RoomMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_game_proto_enumTypes[1].Descriptor()
+}
+
+func (RoomMode) Type() protoreflect.EnumType {
+	return &file_game_proto_enumTypes[1]
+}
+
+func (x RoomMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RoomMode.Descriptor instead.
+func (RoomMode) EnumDescriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{1}
+}
+
+// PlayerDelta.fields 中的字段位
+type PlayerField int32
+
+const (
+	PlayerField_FIELD_NONE      PlayerField = 0
+	PlayerField_FIELD_NAME      PlayerField = 1
+	PlayerField_FIELD_POSITION  PlayerField = 2
+	PlayerField_FIELD_TEAM      PlayerField = 4
+	PlayerField_FIELD_INPUT_SEQ PlayerField = 8
+)
+
+// Enum value maps for PlayerField.
+var (
+	PlayerField_name = map[int32]string{
+		0: "FIELD_NONE",
+		1: "FIELD_NAME",
+		2: "FIELD_POSITION",
+		4: "FIELD_TEAM",
+		8: "FIELD_INPUT_SEQ",
+	}
+	PlayerField_value = map[string]int32{
+		"FIELD_NONE":      0,
+		"FIELD_NAME":      1,
+		"FIELD_POSITION":  2,
+		"FIELD_TEAM":      4,
+		"FIELD_INPUT_SEQ": 8,
+	}
+)
+
+func (x PlayerField) Enum() *PlayerField {
+	p := new(PlayerField)
+	*p = x
+	return p
+}
+
+func (x PlayerField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PlayerField) Descriptor() protoreflect.EnumDescriptor {
+	return file_game_proto_enumTypes[2].Descriptor()
+}
+
+func (PlayerField) Type() protoreflect.EnumType {
+	return &file_game_proto_enumTypes[2]
+}
+
+func (x PlayerField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PlayerField.Descriptor instead.
+func (PlayerField) EnumDescriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{2}
+}
+
+// 自定义消息的转发目标
+type RelayTarget int32
+
+const (
+	RelayTarget_RELAY_NONE    RelayTarget = 0 // 只交给服务器处理, 不转发
+	RelayTarget_RELAY_ALL     RelayTarget = 1 // 房间内所有玩家, 包括发送者
+	RelayTarget_RELAY_OTHERS  RelayTarget = 2 // 除发送者以外的玩家
+	RelayTarget_RELAY_PLAYERS RelayTarget = 3 // targetPlayerIds 指定的玩家
+	RelayTarget_RELAY_OWNER   RelayTarget = 4 // 房主
+)
+
+// Enum value maps for RelayTarget.
+var (
+	RelayTarget_name = map[int32]string{
+		0: "RELAY_NONE",
+		1: "RELAY_ALL",
+		2: "RELAY_OTHERS",
+		3: "RELAY_PLAYERS",
+		4: "RELAY_OWNER",
+	}
+	RelayTarget_value = map[string]int32{
+		"RELAY_NONE":    0,
+		"RELAY_ALL":     1,
+		"RELAY_OTHERS":  2,
+		"RELAY_PLAYERS": 3,
+		"RELAY_OWNER":   4,
+	}
+)
+
+func (x RelayTarget) Enum() *RelayTarget {
+	p := new(RelayTarget)
+	*p = x
+	return p
+}
+
+func (x RelayTarget) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RelayTarget) Descriptor() protoreflect.EnumDescriptor {
+	return file_game_proto_enumTypes[3].Descriptor()
+}
+
+func (RelayTarget) Type() protoreflect.EnumType {
+	return &file_game_proto_enumTypes[3]
+}
+
+func (x RelayTarget) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RelayTarget.Descriptor instead.
+func (RelayTarget) EnumDescriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{3}
+}
+
+type ChatScope int32
+
+const (
+	ChatScope_CHAT_ROOM ChatScope = 0 // 房间内所有玩家
+	ChatScope_CHAT_TEAM ChatScope = 1 // 仅本队玩家
+)
+
+// Enum value maps for ChatScope.
+var (
+	ChatScope_name = map[int32]string{
+		0: "CHAT_ROOM",
+		1: "CHAT_TEAM",
+	}
+	ChatScope_value = map[string]int32{
+		"CHAT_ROOM": 0,
+		"CHAT_TEAM": 1,
+	}
+)
+
+func (x ChatScope) Enum() *ChatScope {
+	p := new(ChatScope)
+	*p = x
+	return p
+}
+
+func (x ChatScope) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChatScope) Descriptor() protoreflect.EnumDescriptor {
+	return file_game_proto_enumTypes[4].Descriptor()
+}
+
+func (ChatScope) Type() protoreflect.EnumType {
+	return &file_game_proto_enumTypes[4]
+}
+
+func (x ChatScope) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChatScope.Descriptor instead.
+func (ChatScope) EnumDescriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{4}
+}
+
 type ErrorCode int32
 
 const (
@@ -28,16 +284,44 @@ const (
 	ErrorCode_ROOM_FULL              ErrorCode = 2
 	ErrorCode_PLAYER_NOT_FOUND       ErrorCode = 3
 	ErrorCode_PLAYER_ALREADY_IN_ROOM ErrorCode = 4
+	ErrorCode_PLAYER_NOT_IN_ROOM     ErrorCode = 5
+	ErrorCode_TEAM_NOT_FOUND         ErrorCode = 6
+	ErrorCode_TEAM_FULL              ErrorCode = 7
+	ErrorCode_ROOM_MODE_MISMATCH     ErrorCode = 8
+	ErrorCode_MAP_NOT_FOUND          ErrorCode = 9
+	ErrorCode_ROOM_TYPE_NOT_FOUND    ErrorCode = 10
+	ErrorCode_INVALID_REQUEST        ErrorCode = 11
+	ErrorCode_INTERNAL_ERROR         ErrorCode = 12
+	ErrorCode_UNKNOWN_MESSAGE        ErrorCode = 13
+	ErrorCode_NOT_LOGGED_IN          ErrorCode = 14
+	ErrorCode_REQUEST_TIMEOUT        ErrorCode = 15
+	ErrorCode_ROOM_UNAVAILABLE       ErrorCode = 16
+	ErrorCode_RATE_LIMITED           ErrorCode = 17
+	ErrorCode_FRAME_HISTORY_EXPIRED  ErrorCode = 18
 )
 
 // Enum value maps for ErrorCode.
 var (
 	ErrorCode_name = map[int32]string{
-		0: "OK",
-		1: "ROOM_NOT_FOUND",
-		2: "ROOM_FULL",
-		3: "PLAYER_NOT_FOUND",
-		4: "PLAYER_ALREADY_IN_ROOM",
+		0:  "OK",
+		1:  "ROOM_NOT_FOUND",
+		2:  "ROOM_FULL",
+		3:  "PLAYER_NOT_FOUND",
+		4:  "PLAYER_ALREADY_IN_ROOM",
+		5:  "PLAYER_NOT_IN_ROOM",
+		6:  "TEAM_NOT_FOUND",
+		7:  "TEAM_FULL",
+		8:  "ROOM_MODE_MISMATCH",
+		9:  "MAP_NOT_FOUND",
+		10: "ROOM_TYPE_NOT_FOUND",
+		11: "INVALID_REQUEST",
+		12: "INTERNAL_ERROR",
+		13: "UNKNOWN_MESSAGE",
+		14: "NOT_LOGGED_IN",
+		15: "REQUEST_TIMEOUT",
+		16: "ROOM_UNAVAILABLE",
+		17: "RATE_LIMITED",
+		18: "FRAME_HISTORY_EXPIRED",
 	}
 	ErrorCode_value = map[string]int32{
 		"OK":                     0,
@@ -45,6 +329,20 @@ var (
 		"ROOM_FULL":              2,
 		"PLAYER_NOT_FOUND":       3,
 		"PLAYER_ALREADY_IN_ROOM": 4,
+		"PLAYER_NOT_IN_ROOM":     5,
+		"TEAM_NOT_FOUND":         6,
+		"TEAM_FULL":              7,
+		"ROOM_MODE_MISMATCH":     8,
+		"MAP_NOT_FOUND":          9,
+		"ROOM_TYPE_NOT_FOUND":    10,
+		"INVALID_REQUEST":        11,
+		"INTERNAL_ERROR":         12,
+		"UNKNOWN_MESSAGE":        13,
+		"NOT_LOGGED_IN":          14,
+		"REQUEST_TIMEOUT":        15,
+		"ROOM_UNAVAILABLE":       16,
+		"RATE_LIMITED":           17,
+		"FRAME_HISTORY_EXPIRED":  18,
 	}
 )
 
@@ -59,11 +357,11 @@ func (x ErrorCode) String() string {
 }
 
 func (ErrorCode) Descriptor() protoreflect.EnumDescriptor {
-	return file_game_proto_enumTypes[0].Descriptor()
+	return file_game_proto_enumTypes[5].Descriptor()
 }
 
 func (ErrorCode) Type() protoreflect.EnumType {
-	return &file_game_proto_enumTypes[0]
+	return &file_game_proto_enumTypes[5]
 }
 
 func (x ErrorCode) Number() protoreflect.EnumNumber {
@@ -72,25 +370,47 @@ func (x ErrorCode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ErrorCode.Descriptor instead.
 func (ErrorCode) EnumDescriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{0}
+	return file_game_proto_rawDescGZIP(), []int{5}
 }
 
 type MessageId int32
 
 const (
-	MessageId_LOGIN_REQUEST           MessageId = 0
-	MessageId_LOGIN_RESPONSE          MessageId = 1
-	MessageId_GET_ROOM_LIST_REQUEST   MessageId = 2
-	MessageId_GET_ROOM_LIST_RESPONSE  MessageId = 3
-	MessageId_CREATE_ROOM_REQUEST     MessageId = 4
-	MessageId_CREATE_ROOM_RESPONSE    MessageId = 5
-	MessageId_JOIN_ROOM_REQUEST       MessageId = 6
-	MessageId_JOIN_ROOM_RESPONSE      MessageId = 7
-	MessageId_MOVE_REQUEST            MessageId = 8
-	MessageId_MOVE_RESPONSE           MessageId = 9
-	MessageId_LEAVE_ROOM_REQUEST      MessageId = 10
-	MessageId_LEAVE_ROOM_RESPONSE     MessageId = 11
-	MessageId_ROOM_STATE_NOTIFICATION MessageId = 12
+	MessageId_LOGIN_REQUEST                MessageId = 0
+	MessageId_LOGIN_RESPONSE               MessageId = 1
+	MessageId_GET_ROOM_LIST_REQUEST        MessageId = 2
+	MessageId_GET_ROOM_LIST_RESPONSE       MessageId = 3
+	MessageId_CREATE_ROOM_REQUEST          MessageId = 4
+	MessageId_CREATE_ROOM_RESPONSE         MessageId = 5
+	MessageId_JOIN_ROOM_REQUEST            MessageId = 6
+	MessageId_JOIN_ROOM_RESPONSE           MessageId = 7
+	MessageId_MOVE_REQUEST                 MessageId = 8
+	MessageId_MOVE_RESPONSE                MessageId = 9
+	MessageId_LEAVE_ROOM_REQUEST           MessageId = 10
+	MessageId_LEAVE_ROOM_RESPONSE          MessageId = 11
+	MessageId_ROOM_STATE_NOTIFICATION      MessageId = 12
+	MessageId_SWITCH_TEAM_REQUEST          MessageId = 13
+	MessageId_SWITCH_TEAM_RESPONSE         MessageId = 14
+	MessageId_CHAT_REQUEST                 MessageId = 15
+	MessageId_CHAT_RESPONSE                MessageId = 16
+	MessageId_CHAT_NOTIFICATION            MessageId = 17
+	MessageId_STATE_DELTA_NOTIFICATION     MessageId = 18
+	MessageId_STATE_ACK                    MessageId = 19
+	MessageId_AOI_ENTER_NOTIFICATION       MessageId = 20
+	MessageId_AOI_LEAVE_NOTIFICATION       MessageId = 21
+	MessageId_FRAME_INPUT_REQUEST          MessageId = 22
+	MessageId_FRAME_NOTIFICATION           MessageId = 23
+	MessageId_FRAME_HISTORY_REQUEST        MessageId = 24
+	MessageId_FRAME_HISTORY_RESPONSE       MessageId = 25
+	MessageId_MOVE_CORRECTION_NOTIFICATION MessageId = 26
+	MessageId_KICK_NOTIFICATION            MessageId = 27
+	MessageId_PLAYER_INPUT_REQUEST         MessageId = 28
+	MessageId_ENTITY_SPAWN_NOTIFICATION    MessageId = 29
+	MessageId_ENTITY_DESPAWN_NOTIFICATION  MessageId = 30
+	MessageId_ENTITY_UPDATE_NOTIFICATION   MessageId = 31
+	MessageId_ROOM_CUSTOM_MESSAGE          MessageId = 32
+	MessageId_ERROR_RESPONSE               MessageId = 33
+	MessageId_SERVER_SHUTDOWN_NOTIFICATION MessageId = 34
 )
 
 // Enum value maps for MessageId.
@@ -109,21 +429,65 @@ var (
 		10: "LEAVE_ROOM_REQUEST",
 		11: "LEAVE_ROOM_RESPONSE",
 		12: "ROOM_STATE_NOTIFICATION",
+		13: "SWITCH_TEAM_REQUEST",
+		14: "SWITCH_TEAM_RESPONSE",
+		15: "CHAT_REQUEST",
+		16: "CHAT_RESPONSE",
+		17: "CHAT_NOTIFICATION",
+		18: "STATE_DELTA_NOTIFICATION",
+		19: "STATE_ACK",
+		20: "AOI_ENTER_NOTIFICATION",
+		21: "AOI_LEAVE_NOTIFICATION",
+		22: "FRAME_INPUT_REQUEST",
+		23: "FRAME_NOTIFICATION",
+		24: "FRAME_HISTORY_REQUEST",
+		25: "FRAME_HISTORY_RESPONSE",
+		26: "MOVE_CORRECTION_NOTIFICATION",
+		27: "KICK_NOTIFICATION",
+		28: "PLAYER_INPUT_REQUEST",
+		29: "ENTITY_SPAWN_NOTIFICATION",
+		30: "ENTITY_DESPAWN_NOTIFICATION",
+		31: "ENTITY_UPDATE_NOTIFICATION",
+		32: "ROOM_CUSTOM_MESSAGE",
+		33: "ERROR_RESPONSE",
+		34: "SERVER_SHUTDOWN_NOTIFICATION",
 	}
 	MessageId_value = map[string]int32{
-		"LOGIN_REQUEST":           0,
-		"LOGIN_RESPONSE":          1,
-		"GET_ROOM_LIST_REQUEST":   2,
-		"GET_ROOM_LIST_RESPONSE":  3,
-		"CREATE_ROOM_REQUEST":     4,
-		"CREATE_ROOM_RESPONSE":    5,
-		"JOIN_ROOM_REQUEST":       6,
-		"JOIN_ROOM_RESPONSE":      7,
-		"MOVE_REQUEST":            8,
-		"MOVE_RESPONSE":           9,
-		"LEAVE_ROOM_REQUEST":      10,
-		"LEAVE_ROOM_RESPONSE":     11,
-		"ROOM_STATE_NOTIFICATION": 12,
+		"LOGIN_REQUEST":                0,
+		"LOGIN_RESPONSE":               1,
+		"GET_ROOM_LIST_REQUEST":        2,
+		"GET_ROOM_LIST_RESPONSE":       3,
+		"CREATE_ROOM_REQUEST":          4,
+		"CREATE_ROOM_RESPONSE":         5,
+		"JOIN_ROOM_REQUEST":            6,
+		"JOIN_ROOM_RESPONSE":           7,
+		"MOVE_REQUEST":                 8,
+		"MOVE_RESPONSE":                9,
+		"LEAVE_ROOM_REQUEST":           10,
+		"LEAVE_ROOM_RESPONSE":          11,
+		"ROOM_STATE_NOTIFICATION":      12,
+		"SWITCH_TEAM_REQUEST":          13,
+		"SWITCH_TEAM_RESPONSE":         14,
+		"CHAT_REQUEST":                 15,
+		"CHAT_RESPONSE":                16,
+		"CHAT_NOTIFICATION":            17,
+		"STATE_DELTA_NOTIFICATION":     18,
+		"STATE_ACK":                    19,
+		"AOI_ENTER_NOTIFICATION":       20,
+		"AOI_LEAVE_NOTIFICATION":       21,
+		"FRAME_INPUT_REQUEST":          22,
+		"FRAME_NOTIFICATION":           23,
+		"FRAME_HISTORY_REQUEST":        24,
+		"FRAME_HISTORY_RESPONSE":       25,
+		"MOVE_CORRECTION_NOTIFICATION": 26,
+		"KICK_NOTIFICATION":            27,
+		"PLAYER_INPUT_REQUEST":         28,
+		"ENTITY_SPAWN_NOTIFICATION":    29,
+		"ENTITY_DESPAWN_NOTIFICATION":  30,
+		"ENTITY_UPDATE_NOTIFICATION":   31,
+		"ROOM_CUSTOM_MESSAGE":          32,
+		"ERROR_RESPONSE":               33,
+		"SERVER_SHUTDOWN_NOTIFICATION": 34,
 	}
 )
 
@@ -138,11 +502,11 @@ func (x MessageId) String() string {
 }
 
 func (MessageId) Descriptor() protoreflect.EnumDescriptor {
-	return file_game_proto_enumTypes[1].Descriptor()
+	return file_game_proto_enumTypes[6].Descriptor()
 }
 
 func (MessageId) Type() protoreflect.EnumType {
-	return &file_game_proto_enumTypes[1]
+	return &file_game_proto_enumTypes[6]
 }
 
 func (x MessageId) Number() protoreflect.EnumNumber {
@@ -151,7 +515,7 @@ func (x MessageId) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use MessageId.Descriptor instead.
 func (MessageId) EnumDescriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{1}
+	return file_game_proto_rawDescGZIP(), []int{6}
 }
 
 type Position struct {
@@ -220,9 +584,11 @@ type Player struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id       string    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name     string    `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Position *Position `protobuf:"bytes,3,opt,name=position,proto3" json:"position,omitempty"`
+	Id           string    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name         string    `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Position     *Position `protobuf:"bytes,3,opt,name=position,proto3" json:"position,omitempty"`
+	TeamId       int32     `protobuf:"varint,4,opt,name=teamId,proto3" json:"teamId,omitempty"`             // 所在队伍, 0 表示未分队
+	LastInputSeq uint32    `protobuf:"varint,5,opt,name=lastInputSeq,proto3" json:"lastInputSeq,omitempty"` // 权威模拟房间中服务器已处理的最新输入序号
 }
 
 func (x *Player) Reset() {
@@ -276,30 +642,43 @@ func (x *Player) GetPosition() *Position {
 	return nil
 }
 
-type Room struct {
+func (x *Player) GetTeamId() int32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *Player) GetLastInputSeq() uint32 {
+	if x != nil {
+		return x.LastInputSeq
+	}
+	return 0
+}
+
+type HealthComponent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id      uint64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name    string    `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Players []*Player `protobuf:"bytes,3,rep,name=players,proto3" json:"players,omitempty"`
+	Hp    int32 `protobuf:"varint,1,opt,name=hp,proto3" json:"hp,omitempty"`
+	MaxHp int32 `protobuf:"varint,2,opt,name=maxHp,proto3" json:"maxHp,omitempty"`
 }
 
-func (x *Room) Reset() {
-	*x = Room{}
+func (x *HealthComponent) Reset() {
+	*x = HealthComponent{}
 	mi := &file_game_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Room) String() string {
+func (x *HealthComponent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Room) ProtoMessage() {}
+func (*HealthComponent) ProtoMessage() {}
 
-func (x *Room) ProtoReflect() protoreflect.Message {
+func (x *HealthComponent) ProtoReflect() protoreflect.Message {
 	mi := &file_game_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -311,54 +690,47 @@ func (x *Room) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Room.ProtoReflect.Descriptor instead.
-func (*Room) Descriptor() ([]byte, []int) {
+// Deprecated: Use HealthComponent.ProtoReflect.Descriptor instead.
+func (*HealthComponent) Descriptor() ([]byte, []int) {
 	return file_game_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *Room) GetId() uint64 {
+func (x *HealthComponent) GetHp() int32 {
 	if x != nil {
-		return x.Id
+		return x.Hp
 	}
 	return 0
 }
 
-func (x *Room) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *Room) GetPlayers() []*Player {
+func (x *HealthComponent) GetMaxHp() int32 {
 	if x != nil {
-		return x.Players
+		return x.MaxHp
 	}
-	return nil
+	return 0
 }
 
-type LoginRequest struct {
+type VelocityComponent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PlayerName string `protobuf:"bytes,1,opt,name=playerName,proto3" json:"playerName,omitempty"`
+	Velocity *Position `protobuf:"bytes,1,opt,name=velocity,proto3" json:"velocity,omitempty"`
 }
 
-func (x *LoginRequest) Reset() {
-	*x = LoginRequest{}
+func (x *VelocityComponent) Reset() {
+	*x = VelocityComponent{}
 	mi := &file_game_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LoginRequest) String() string {
+func (x *VelocityComponent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoginRequest) ProtoMessage() {}
+func (*VelocityComponent) ProtoMessage() {}
 
-func (x *LoginRequest) ProtoReflect() protoreflect.Message {
+func (x *VelocityComponent) ProtoReflect() protoreflect.Message {
 	mi := &file_game_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -370,19 +742,19 @@ func (x *LoginRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
-func (*LoginRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use VelocityComponent.ProtoReflect.Descriptor instead.
+func (*VelocityComponent) Descriptor() ([]byte, []int) {
 	return file_game_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *LoginRequest) GetPlayerName() string {
+func (x *VelocityComponent) GetVelocity() *Position {
 	if x != nil {
-		return x.PlayerName
+		return x.Velocity
 	}
-	return ""
+	return nil
 }
 
-type LoginResponse struct {
+type OwnerComponent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -390,20 +762,20 @@ type LoginResponse struct {
 	PlayerId string `protobuf:"bytes,1,opt,name=playerId,proto3" json:"playerId,omitempty"`
 }
 
-func (x *LoginResponse) Reset() {
-	*x = LoginResponse{}
+func (x *OwnerComponent) Reset() {
+	*x = OwnerComponent{}
 	mi := &file_game_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LoginResponse) String() string {
+func (x *OwnerComponent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoginResponse) ProtoMessage() {}
+func (*OwnerComponent) ProtoMessage() {}
 
-func (x *LoginResponse) ProtoReflect() protoreflect.Message {
+func (x *OwnerComponent) ProtoReflect() protoreflect.Message {
 	mi := &file_game_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -415,38 +787,41 @@ func (x *LoginResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoginResponse.ProtoReflect.Descriptor instead.
-func (*LoginResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use OwnerComponent.ProtoReflect.Descriptor instead.
+func (*OwnerComponent) Descriptor() ([]byte, []int) {
 	return file_game_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *LoginResponse) GetPlayerId() string {
+func (x *OwnerComponent) GetPlayerId() string {
 	if x != nil {
 		return x.PlayerId
 	}
 	return ""
 }
 
-type GetRoomListRequest struct {
+type PickupComponent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Item   string `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	Amount int32  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (x *GetRoomListRequest) Reset() {
-	*x = GetRoomListRequest{}
+func (x *PickupComponent) Reset() {
+	*x = PickupComponent{}
 	mi := &file_game_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRoomListRequest) String() string {
+func (x *PickupComponent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRoomListRequest) ProtoMessage() {}
+func (*PickupComponent) ProtoMessage() {}
 
-func (x *GetRoomListRequest) ProtoReflect() protoreflect.Message {
+func (x *PickupComponent) ProtoReflect() protoreflect.Message {
 	mi := &file_game_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -458,34 +833,55 @@ func (x *GetRoomListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRoomListRequest.ProtoReflect.Descriptor instead.
-func (*GetRoomListRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PickupComponent.ProtoReflect.Descriptor instead.
+func (*PickupComponent) Descriptor() ([]byte, []int) {
 	return file_game_proto_rawDescGZIP(), []int{5}
 }
 
-type GetRoomListResponse struct {
+func (x *PickupComponent) GetItem() string {
+	if x != nil {
+		return x.Item
+	}
+	return ""
+}
+
+func (x *PickupComponent) GetAmount() int32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+// 房间内的非玩家对象, id 由服务器分配, 组件为空表示实体没有该组件
+type Entity struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Ret   ErrorCode `protobuf:"varint,1,opt,name=ret,proto3,enum=game.ErrorCode" json:"ret,omitempty"`
-	Rooms []*Room   `protobuf:"bytes,2,rep,name=rooms,proto3" json:"rooms,omitempty"`
+	Id       uint64             `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type     EntityType         `protobuf:"varint,2,opt,name=type,proto3,enum=game.EntityType" json:"type,omitempty"`
+	Position *Position          `protobuf:"bytes,3,opt,name=position,proto3" json:"position,omitempty"`
+	Health   *HealthComponent   `protobuf:"bytes,4,opt,name=health,proto3" json:"health,omitempty"`
+	Velocity *VelocityComponent `protobuf:"bytes,5,opt,name=velocity,proto3" json:"velocity,omitempty"`
+	Owner    *OwnerComponent    `protobuf:"bytes,6,opt,name=owner,proto3" json:"owner,omitempty"`
+	Pickup   *PickupComponent   `protobuf:"bytes,7,opt,name=pickup,proto3" json:"pickup,omitempty"`
+	Custom   []byte             `protobuf:"bytes,8,opt,name=custom,proto3" json:"custom,omitempty"` // 游戏自定义数据
 }
 
-func (x *GetRoomListResponse) Reset() {
-	*x = GetRoomListResponse{}
+func (x *Entity) Reset() {
+	*x = Entity{}
 	mi := &file_game_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRoomListResponse) String() string {
+func (x *Entity) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRoomListResponse) ProtoMessage() {}
+func (*Entity) ProtoMessage() {}
 
-func (x *GetRoomListResponse) ProtoReflect() protoreflect.Message {
+func (x *Entity) ProtoReflect() protoreflect.Message {
 	mi := &file_game_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -497,94 +893,1891 @@ func (x *GetRoomListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRoomListResponse.ProtoReflect.Descriptor instead.
-func (*GetRoomListResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Entity.ProtoReflect.Descriptor instead.
+func (*Entity) Descriptor() ([]byte, []int) {
 	return file_game_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetRoomListResponse) GetRet() ErrorCode {
+func (x *Entity) GetId() uint64 {
 	if x != nil {
-		return x.Ret
+		return x.Id
 	}
-	return ErrorCode_OK
+	return 0
 }
 
-func (x *GetRoomListResponse) GetRooms() []*Room {
+func (x *Entity) GetType() EntityType {
 	if x != nil {
-		return x.Rooms
+		return x.Type
 	}
-	return nil
+	return EntityType_ENTITY_UNKNOWN
 }
 
-type CreateRoomRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+func (x *Entity) GetPosition() *Position {
+	if x != nil {
+		return x.Position
+	}
+	return nil
 }
 
-func (x *CreateRoomRequest) Reset() {
-	*x = CreateRoomRequest{}
-	mi := &file_game_proto_msgTypes[7]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Entity) GetHealth() *HealthComponent {
+	if x != nil {
+		return x.Health
+	}
+	return nil
 }
 
-func (x *CreateRoomRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Entity) GetVelocity() *VelocityComponent {
+	if x != nil {
+		return x.Velocity
+	}
+	return nil
 }
 
-func (*CreateRoomRequest) ProtoMessage() {}
-
-func (x *CreateRoomRequest) ProtoReflect() protoreflect.Message {
+func (x *Entity) GetOwner() *OwnerComponent {
+	if x != nil {
+		return x.Owner
+	}
+	return nil
+}
+
+func (x *Entity) GetPickup() *PickupComponent {
+	if x != nil {
+		return x.Pickup
+	}
+	return nil
+}
+
+func (x *Entity) GetCustom() []byte {
+	if x != nil {
+		return x.Custom
+	}
+	return nil
+}
+
+type Room struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            uint64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string    `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Players       []*Player `protobuf:"bytes,3,rep,name=players,proto3" json:"players,omitempty"`
+	TeamCount     int32     `protobuf:"varint,4,opt,name=teamCount,proto3" json:"teamCount,omitempty"`  // 队伍数量, 0 表示不分队
+	TeamSize      int32     `protobuf:"varint,5,opt,name=teamSize,proto3" json:"teamSize,omitempty"`    // 每队人数上限, 0 表示不限
+	TickRate      int32     `protobuf:"varint,6,opt,name=tickRate,proto3" json:"tickRate,omitempty"`    // 房间逻辑帧率(Hz), 0 表示事件驱动
+	DeltaSync     bool      `protobuf:"varint,7,opt,name=deltaSync,proto3" json:"deltaSync,omitempty"`  // 是否使用增量状态同步
+	AoiRadius     float32   `protobuf:"fixed32,8,opt,name=aoiRadius,proto3" json:"aoiRadius,omitempty"` // 视野半径, 0 表示不做视野过滤
+	Mode          RoomMode  `protobuf:"varint,9,opt,name=mode,proto3,enum=game.RoomMode" json:"mode,omitempty"`
+	InputDelay    int32     `protobuf:"varint,10,opt,name=inputDelay,proto3" json:"inputDelay,omitempty"`       // 帧同步输入延迟(帧)
+	Authoritative bool      `protobuf:"varint,11,opt,name=authoritative,proto3" json:"authoritative,omitempty"` // 服务器权威移动模拟
+	MapName       string    `protobuf:"bytes,12,opt,name=mapName,proto3" json:"mapName,omitempty"`
+	Entities      []*Entity `protobuf:"bytes,13,rep,name=entities,proto3" json:"entities,omitempty"`
+	RoomType      string    `protobuf:"bytes,14,opt,name=roomType,proto3" json:"roomType,omitempty"`
+	OwnerId       string    `protobuf:"bytes,15,opt,name=ownerId,proto3" json:"ownerId,omitempty"` // 房主, 默认为创建者, 离开后转给其他玩家
+}
+
+func (x *Room) Reset() {
+	*x = Room{}
+	mi := &file_game_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Room) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Room) ProtoMessage() {}
+
+func (x *Room) ProtoReflect() protoreflect.Message {
 	mi := &file_game_proto_msgTypes[7]
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Room.ProtoReflect.Descriptor instead.
+func (*Room) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Room) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Room) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Room) GetPlayers() []*Player {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *Room) GetTeamCount() int32 {
+	if x != nil {
+		return x.TeamCount
+	}
+	return 0
+}
+
+func (x *Room) GetTeamSize() int32 {
+	if x != nil {
+		return x.TeamSize
+	}
+	return 0
+}
+
+func (x *Room) GetTickRate() int32 {
+	if x != nil {
+		return x.TickRate
+	}
+	return 0
+}
+
+func (x *Room) GetDeltaSync() bool {
+	if x != nil {
+		return x.DeltaSync
+	}
+	return false
+}
+
+func (x *Room) GetAoiRadius() float32 {
+	if x != nil {
+		return x.AoiRadius
+	}
+	return 0
+}
+
+func (x *Room) GetMode() RoomMode {
+	if x != nil {
+		return x.Mode
+	}
+	return RoomMode_MODE_STATE_SYNC
+}
+
+func (x *Room) GetInputDelay() int32 {
+	if x != nil {
+		return x.InputDelay
+	}
+	return 0
+}
+
+func (x *Room) GetAuthoritative() bool {
+	if x != nil {
+		return x.Authoritative
+	}
+	return false
+}
+
+func (x *Room) GetMapName() string {
+	if x != nil {
+		return x.MapName
+	}
+	return ""
+}
+
+func (x *Room) GetEntities() []*Entity {
+	if x != nil {
+		return x.Entities
+	}
+	return nil
+}
+
+func (x *Room) GetRoomType() string {
+	if x != nil {
+		return x.RoomType
+	}
+	return ""
+}
+
+func (x *Room) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+type LoginRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerName string `protobuf:"bytes,1,opt,name=playerName,proto3" json:"playerName,omitempty"`
+}
+
+func (x *LoginRequest) Reset() {
+	*x = LoginRequest{}
+	mi := &file_game_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginRequest) ProtoMessage() {}
+
+func (x *LoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
+func (*LoginRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *LoginRequest) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+type LoginResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId string `protobuf:"bytes,1,opt,name=playerId,proto3" json:"playerId,omitempty"`
+}
+
+func (x *LoginResponse) Reset() {
+	*x = LoginResponse{}
+	mi := &file_game_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginResponse) ProtoMessage() {}
+
+func (x *LoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginResponse.ProtoReflect.Descriptor instead.
+func (*LoginResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *LoginResponse) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+type GetRoomListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetRoomListRequest) Reset() {
+	*x = GetRoomListRequest{}
+	mi := &file_game_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRoomListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoomListRequest) ProtoMessage() {}
+
+func (x *GetRoomListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoomListRequest.ProtoReflect.Descriptor instead.
+func (*GetRoomListRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{10}
+}
+
+type GetRoomListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ret   ErrorCode `protobuf:"varint,1,opt,name=ret,proto3,enum=game.ErrorCode" json:"ret,omitempty"`
+	Rooms []*Room   `protobuf:"bytes,2,rep,name=rooms,proto3" json:"rooms,omitempty"`
+}
+
+func (x *GetRoomListResponse) Reset() {
+	*x = GetRoomListResponse{}
+	mi := &file_game_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRoomListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoomListResponse) ProtoMessage() {}
+
+func (x *GetRoomListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoomListResponse.ProtoReflect.Descriptor instead.
+func (*GetRoomListResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetRoomListResponse) GetRet() ErrorCode {
+	if x != nil {
+		return x.Ret
+	}
+	return ErrorCode_OK
+}
+
+func (x *GetRoomListResponse) GetRooms() []*Room {
+	if x != nil {
+		return x.Rooms
+	}
+	return nil
+}
+
+type CreateRoomRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name          string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	TeamCount     int32    `protobuf:"varint,2,opt,name=teamCount,proto3" json:"teamCount,omitempty"`
+	TeamSize      int32    `protobuf:"varint,3,opt,name=teamSize,proto3" json:"teamSize,omitempty"`
+	TeamId        int32    `protobuf:"varint,4,opt,name=teamId,proto3" json:"teamId,omitempty"`        // 创建者期望加入的队伍, 0 表示自动分配
+	TickRate      int32    `protobuf:"varint,5,opt,name=tickRate,proto3" json:"tickRate,omitempty"`    // 房间逻辑帧率(Hz), 0 表示事件驱动
+	DeltaSync     bool     `protobuf:"varint,6,opt,name=deltaSync,proto3" json:"deltaSync,omitempty"`  // 使用 StateDeltaNotification 代替 RoomStateNotification
+	AoiRadius     float32  `protobuf:"fixed32,7,opt,name=aoiRadius,proto3" json:"aoiRadius,omitempty"` // 视野半径(x/y 平面), 0 表示所有玩家互相可见
+	Mode          RoomMode `protobuf:"varint,8,opt,name=mode,proto3,enum=game.RoomMode" json:"mode,omitempty"`
+	InputDelay    int32    `protobuf:"varint,9,opt,name=inputDelay,proto3" json:"inputDelay,omitempty"`        // 帧同步模式下输入生效前延迟的帧数
+	Authoritative bool     `protobuf:"varint,10,opt,name=authoritative,proto3" json:"authoritative,omitempty"` // 客户端只发送输入, 位置由服务器模拟
+	MapName       string   `protobuf:"bytes,11,opt,name=mapName,proto3" json:"mapName,omitempty"`              // 权威模拟使用的静态地图, 对应服务器地图目录下的 <mapName>.json
+	RoomType      string   `protobuf:"bytes,12,opt,name=roomType,proto3" json:"roomType,omitempty"`            // 游戏模式, 对应服务器注册的 RoomLogic, 为空时使用默认逻辑
+}
+
+func (x *CreateRoomRequest) Reset() {
+	*x = CreateRoomRequest{}
+	mi := &file_game_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoomRequest) ProtoMessage() {}
+
+func (x *CreateRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoomRequest.ProtoReflect.Descriptor instead.
+func (*CreateRoomRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CreateRoomRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateRoomRequest) GetTeamCount() int32 {
+	if x != nil {
+		return x.TeamCount
+	}
+	return 0
+}
+
+func (x *CreateRoomRequest) GetTeamSize() int32 {
+	if x != nil {
+		return x.TeamSize
+	}
+	return 0
+}
+
+func (x *CreateRoomRequest) GetTeamId() int32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *CreateRoomRequest) GetTickRate() int32 {
+	if x != nil {
+		return x.TickRate
+	}
+	return 0
+}
+
+func (x *CreateRoomRequest) GetDeltaSync() bool {
+	if x != nil {
+		return x.DeltaSync
+	}
+	return false
+}
+
+func (x *CreateRoomRequest) GetAoiRadius() float32 {
+	if x != nil {
+		return x.AoiRadius
+	}
+	return 0
+}
+
+func (x *CreateRoomRequest) GetMode() RoomMode {
+	if x != nil {
+		return x.Mode
+	}
+	return RoomMode_MODE_STATE_SYNC
+}
+
+func (x *CreateRoomRequest) GetInputDelay() int32 {
+	if x != nil {
+		return x.InputDelay
+	}
+	return 0
+}
+
+func (x *CreateRoomRequest) GetAuthoritative() bool {
+	if x != nil {
+		return x.Authoritative
+	}
+	return false
+}
+
+func (x *CreateRoomRequest) GetMapName() string {
+	if x != nil {
+		return x.MapName
+	}
+	return ""
+}
+
+func (x *CreateRoomRequest) GetRoomType() string {
+	if x != nil {
+		return x.RoomType
+	}
+	return ""
+}
+
+type CreateRoomResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ret  ErrorCode `protobuf:"varint,1,opt,name=ret,proto3,enum=game.ErrorCode" json:"ret,omitempty"`
+	Room *Room     `protobuf:"bytes,2,opt,name=room,proto3" json:"room,omitempty"`
+}
+
+func (x *CreateRoomResponse) Reset() {
+	*x = CreateRoomResponse{}
+	mi := &file_game_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRoomResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoomResponse) ProtoMessage() {}
+
+func (x *CreateRoomResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoomResponse.ProtoReflect.Descriptor instead.
+func (*CreateRoomResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CreateRoomResponse) GetRet() ErrorCode {
+	if x != nil {
+		return x.Ret
+	}
+	return ErrorCode_OK
+}
+
+func (x *CreateRoomResponse) GetRoom() *Room {
+	if x != nil {
+		return x.Room
+	}
+	return nil
+}
+
+type JoinRoomRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Player *Player `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	RoomId uint64  `protobuf:"varint,2,opt,name=roomId,proto3" json:"roomId,omitempty"`
+	TeamId int32   `protobuf:"varint,3,opt,name=teamId,proto3" json:"teamId,omitempty"` // 期望加入的队伍, 0 表示自动分配
+}
+
+func (x *JoinRoomRequest) Reset() {
+	*x = JoinRoomRequest{}
+	mi := &file_game_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinRoomRequest) ProtoMessage() {}
+
+func (x *JoinRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinRoomRequest.ProtoReflect.Descriptor instead.
+func (*JoinRoomRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *JoinRoomRequest) GetPlayer() *Player {
+	if x != nil {
+		return x.Player
+	}
+	return nil
+}
+
+func (x *JoinRoomRequest) GetRoomId() uint64 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+func (x *JoinRoomRequest) GetTeamId() int32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+type JoinRoomResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ret  ErrorCode `protobuf:"varint,1,opt,name=ret,proto3,enum=game.ErrorCode" json:"ret,omitempty"`
+	Room *Room     `protobuf:"bytes,2,opt,name=room,proto3" json:"room,omitempty"`
+}
+
+func (x *JoinRoomResponse) Reset() {
+	*x = JoinRoomResponse{}
+	mi := &file_game_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinRoomResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinRoomResponse) ProtoMessage() {}
+
+func (x *JoinRoomResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinRoomResponse.ProtoReflect.Descriptor instead.
+func (*JoinRoomResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *JoinRoomResponse) GetRet() ErrorCode {
+	if x != nil {
+		return x.Ret
+	}
+	return ErrorCode_OK
+}
+
+func (x *JoinRoomResponse) GetRoom() *Room {
+	if x != nil {
+		return x.Room
+	}
+	return nil
+}
+
+type MoveRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId string    `protobuf:"bytes,1,opt,name=playerId,proto3" json:"playerId,omitempty"` // 已废弃, 服务器以连接对应的玩家为准
+	Position *Position `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
+}
+
+func (x *MoveRequest) Reset() {
+	*x = MoveRequest{}
+	mi := &file_game_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveRequest) ProtoMessage() {}
+
+func (x *MoveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveRequest.ProtoReflect.Descriptor instead.
+func (*MoveRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *MoveRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *MoveRequest) GetPosition() *Position {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+type MoveResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ret  ErrorCode `protobuf:"varint,1,opt,name=ret,proto3,enum=game.ErrorCode" json:"ret,omitempty"`
+	Room *Room     `protobuf:"bytes,2,opt,name=room,proto3" json:"room,omitempty"`
+}
+
+func (x *MoveResponse) Reset() {
+	*x = MoveResponse{}
+	mi := &file_game_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveResponse) ProtoMessage() {}
+
+func (x *MoveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveResponse.ProtoReflect.Descriptor instead.
+func (*MoveResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *MoveResponse) GetRet() ErrorCode {
+	if x != nil {
+		return x.Ret
+	}
+	return ErrorCode_OK
+}
+
+func (x *MoveResponse) GetRoom() *Room {
+	if x != nil {
+		return x.Room
+	}
+	return nil
+}
+
+type LeaveRoomRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId string `protobuf:"bytes,1,opt,name=playerId,proto3" json:"playerId,omitempty"`
+}
+
+func (x *LeaveRoomRequest) Reset() {
+	*x = LeaveRoomRequest{}
+	mi := &file_game_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveRoomRequest) ProtoMessage() {}
+
+func (x *LeaveRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveRoomRequest.ProtoReflect.Descriptor instead.
+func (*LeaveRoomRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *LeaveRoomRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+type LeaveRoomResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ret  ErrorCode `protobuf:"varint,1,opt,name=ret,proto3,enum=game.ErrorCode" json:"ret,omitempty"`
+	Room *Room     `protobuf:"bytes,2,opt,name=room,proto3" json:"room,omitempty"`
+}
+
+func (x *LeaveRoomResponse) Reset() {
+	*x = LeaveRoomResponse{}
+	mi := &file_game_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveRoomResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveRoomResponse) ProtoMessage() {}
+
+func (x *LeaveRoomResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveRoomResponse.ProtoReflect.Descriptor instead.
+func (*LeaveRoomResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *LeaveRoomResponse) GetRet() ErrorCode {
+	if x != nil {
+		return x.Ret
+	}
+	return ErrorCode_OK
+}
+
+func (x *LeaveRoomResponse) GetRoom() *Room {
+	if x != nil {
+		return x.Room
+	}
+	return nil
+}
+
+type RoomStateNotification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Room *Room `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+}
+
+func (x *RoomStateNotification) Reset() {
+	*x = RoomStateNotification{}
+	mi := &file_game_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoomStateNotification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomStateNotification) ProtoMessage() {}
+
+func (x *RoomStateNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomStateNotification.ProtoReflect.Descriptor instead.
+func (*RoomStateNotification) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RoomStateNotification) GetRoom() *Room {
+	if x != nil {
+		return x.Room
+	}
+	return nil
+}
+
+type PlayerDelta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           string    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Fields       uint32    `protobuf:"varint,2,opt,name=fields,proto3" json:"fields,omitempty"` // PlayerField 位组合, 只有置位的字段有效
+	Name         string    `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Position     *Position `protobuf:"bytes,4,opt,name=position,proto3" json:"position,omitempty"`
+	TeamId       int32     `protobuf:"varint,5,opt,name=teamId,proto3" json:"teamId,omitempty"`
+	LastInputSeq uint32    `protobuf:"varint,6,opt,name=lastInputSeq,proto3" json:"lastInputSeq,omitempty"`
+}
+
+func (x *PlayerDelta) Reset() {
+	*x = PlayerDelta{}
+	mi := &file_game_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerDelta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerDelta) ProtoMessage() {}
+
+func (x *PlayerDelta) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerDelta.ProtoReflect.Descriptor instead.
+func (*PlayerDelta) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *PlayerDelta) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PlayerDelta) GetFields() uint32 {
+	if x != nil {
+		return x.Fields
+	}
+	return 0
+}
+
+func (x *PlayerDelta) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlayerDelta) GetPosition() *Position {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *PlayerDelta) GetTeamId() int32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *PlayerDelta) GetLastInputSeq() uint32 {
+	if x != nil {
+		return x.LastInputSeq
+	}
+	return 0
+}
+
+// 增量状态同步, 相对客户端最近确认的快照 baseSeq 计算
+type StateDeltaNotification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seq             uint32         `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	BaseSeq         uint32         `protobuf:"varint,2,opt,name=baseSeq,proto3" json:"baseSeq,omitempty"`
+	Full            bool           `protobuf:"varint,3,opt,name=full,proto3" json:"full,omitempty"` // 全量快照, 客户端应丢弃本地状态
+	Players         []*PlayerDelta `protobuf:"bytes,4,rep,name=players,proto3" json:"players,omitempty"`
+	Removed         []string       `protobuf:"bytes,5,rep,name=removed,proto3" json:"removed,omitempty"`                         // 已离开房间的玩家
+	Entities        []*Entity      `protobuf:"bytes,6,rep,name=entities,proto3" json:"entities,omitempty"`                       // 新增或有变化的实体, 总是完整下发
+	RemovedEntities []uint64       `protobuf:"varint,7,rep,packed,name=removedEntities,proto3" json:"removedEntities,omitempty"` // 已销毁或离开视野的实体
+}
+
+func (x *StateDeltaNotification) Reset() {
+	*x = StateDeltaNotification{}
+	mi := &file_game_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StateDeltaNotification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateDeltaNotification) ProtoMessage() {}
+
+func (x *StateDeltaNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateDeltaNotification.ProtoReflect.Descriptor instead.
+func (*StateDeltaNotification) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *StateDeltaNotification) GetSeq() uint32 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *StateDeltaNotification) GetBaseSeq() uint32 {
+	if x != nil {
+		return x.BaseSeq
+	}
+	return 0
+}
+
+func (x *StateDeltaNotification) GetFull() bool {
+	if x != nil {
+		return x.Full
+	}
+	return false
+}
+
+func (x *StateDeltaNotification) GetPlayers() []*PlayerDelta {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *StateDeltaNotification) GetRemoved() []string {
+	if x != nil {
+		return x.Removed
+	}
+	return nil
+}
+
+func (x *StateDeltaNotification) GetEntities() []*Entity {
+	if x != nil {
+		return x.Entities
+	}
+	return nil
+}
+
+func (x *StateDeltaNotification) GetRemovedEntities() []uint64 {
+	if x != nil {
+		return x.RemovedEntities
+	}
+	return nil
+}
+
+// 客户端确认已应用的快照序号, 无响应
+type StateAck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seq uint32 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+}
+
+func (x *StateAck) Reset() {
+	*x = StateAck{}
+	mi := &file_game_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StateAck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateAck) ProtoMessage() {}
+
+func (x *StateAck) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateAck.ProtoReflect.Descriptor instead.
+func (*StateAck) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *StateAck) GetSeq() uint32 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+// 其他玩家进入视野
+type AoiEnterNotification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Players []*Player `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`
+}
+
+func (x *AoiEnterNotification) Reset() {
+	*x = AoiEnterNotification{}
+	mi := &file_game_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AoiEnterNotification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AoiEnterNotification) ProtoMessage() {}
+
+func (x *AoiEnterNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AoiEnterNotification.ProtoReflect.Descriptor instead.
+func (*AoiEnterNotification) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *AoiEnterNotification) GetPlayers() []*Player {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+// 其他玩家离开视野
+type AoiLeaveNotification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerIds []string `protobuf:"bytes,1,rep,name=playerIds,proto3" json:"playerIds,omitempty"`
+}
+
+func (x *AoiLeaveNotification) Reset() {
+	*x = AoiLeaveNotification{}
+	mi := &file_game_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AoiLeaveNotification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AoiLeaveNotification) ProtoMessage() {}
+
+func (x *AoiLeaveNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AoiLeaveNotification.ProtoReflect.Descriptor instead.
+func (*AoiLeaveNotification) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *AoiLeaveNotification) GetPlayerIds() []string {
+	if x != nil {
+		return x.PlayerIds
+	}
+	return nil
+}
+
+// 客户端提交的帧同步输入, 无响应
+type FrameInputRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Command []byte `protobuf:"bytes,1,opt,name=command,proto3" json:"command,omitempty"`
+}
+
+func (x *FrameInputRequest) Reset() {
+	*x = FrameInputRequest{}
+	mi := &file_game_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FrameInputRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrameInputRequest) ProtoMessage() {}
+
+func (x *FrameInputRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FrameInputRequest.ProtoReflect.Descriptor instead.
+func (*FrameInputRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *FrameInputRequest) GetCommand() []byte {
+	if x != nil {
+		return x.Command
+	}
+	return nil
+}
+
+type FrameInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId string `protobuf:"bytes,1,opt,name=playerId,proto3" json:"playerId,omitempty"`
+	Command  []byte `protobuf:"bytes,2,opt,name=command,proto3" json:"command,omitempty"`
+}
+
+func (x *FrameInput) Reset() {
+	*x = FrameInput{}
+	mi := &file_game_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FrameInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrameInput) ProtoMessage() {}
+
+func (x *FrameInput) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FrameInput.ProtoReflect.Descriptor instead.
+func (*FrameInput) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *FrameInput) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *FrameInput) GetCommand() []byte {
+	if x != nil {
+		return x.Command
+	}
+	return nil
+}
+
+type Frame struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FrameId uint32        `protobuf:"varint,1,opt,name=frameId,proto3" json:"frameId,omitempty"`
+	Inputs  []*FrameInput `protobuf:"bytes,2,rep,name=inputs,proto3" json:"inputs,omitempty"` // 空帧也会下发
+}
+
+func (x *Frame) Reset() {
+	*x = Frame{}
+	mi := &file_game_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Frame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Frame) ProtoMessage() {}
+
+func (x *Frame) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Frame.ProtoReflect.Descriptor instead.
+func (*Frame) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *Frame) GetFrameId() uint32 {
+	if x != nil {
+		return x.FrameId
+	}
+	return 0
+}
+
+func (x *Frame) GetInputs() []*FrameInput {
+	if x != nil {
+		return x.Inputs
+	}
+	return nil
+}
+
+type FrameNotification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Frames []*Frame `protobuf:"bytes,1,rep,name=frames,proto3" json:"frames,omitempty"`
+}
+
+func (x *FrameNotification) Reset() {
+	*x = FrameNotification{}
+	mi := &file_game_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FrameNotification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrameNotification) ProtoMessage() {}
+
+func (x *FrameNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FrameNotification.ProtoReflect.Descriptor instead.
+func (*FrameNotification) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *FrameNotification) GetFrames() []*Frame {
+	if x != nil {
+		return x.Frames
+	}
+	return nil
+}
+
+// 拉取 fromFrame 开始的历史帧, 用于中途加入或断线重连
+type FrameHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromFrame uint32 `protobuf:"varint,1,opt,name=fromFrame,proto3" json:"fromFrame,omitempty"`
+}
+
+func (x *FrameHistoryRequest) Reset() {
+	*x = FrameHistoryRequest{}
+	mi := &file_game_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FrameHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrameHistoryRequest) ProtoMessage() {}
+
+func (x *FrameHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FrameHistoryRequest.ProtoReflect.Descriptor instead.
+func (*FrameHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *FrameHistoryRequest) GetFromFrame() uint32 {
+	if x != nil {
+		return x.FromFrame
+	}
+	return 0
+}
+
+type FrameHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ret          ErrorCode `protobuf:"varint,1,opt,name=ret,proto3,enum=game.ErrorCode" json:"ret,omitempty"`
+	Frames       []*Frame  `protobuf:"bytes,2,rep,name=frames,proto3" json:"frames,omitempty"`
+	CurrentFrame uint32    `protobuf:"varint,3,opt,name=currentFrame,proto3" json:"currentFrame,omitempty"` // 服务器下一帧的帧号, 用于判断是否还需继续拉取
+	OldestFrame  uint32    `protobuf:"varint,4,opt,name=oldestFrame,proto3" json:"oldestFrame,omitempty"`   // 服务器仍保留的最早帧号, 更早的帧已被淘汰
+}
+
+func (x *FrameHistoryResponse) Reset() {
+	*x = FrameHistoryResponse{}
+	mi := &file_game_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FrameHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrameHistoryResponse) ProtoMessage() {}
+
+func (x *FrameHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FrameHistoryResponse.ProtoReflect.Descriptor instead.
+func (*FrameHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *FrameHistoryResponse) GetRet() ErrorCode {
+	if x != nil {
+		return x.Ret
+	}
+	return ErrorCode_OK
+}
+
+func (x *FrameHistoryResponse) GetFrames() []*Frame {
+	if x != nil {
+		return x.Frames
+	}
+	return nil
+}
+
+func (x *FrameHistoryResponse) GetCurrentFrame() uint32 {
+	if x != nil {
+		return x.CurrentFrame
+	}
+	return 0
+}
+
+func (x *FrameHistoryResponse) GetOldestFrame() uint32 {
+	if x != nil {
+		return x.OldestFrame
+	}
+	return 0
+}
+
+type EntitySpawnNotification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entities []*Entity `protobuf:"bytes,1,rep,name=entities,proto3" json:"entities,omitempty"`
+}
+
+func (x *EntitySpawnNotification) Reset() {
+	*x = EntitySpawnNotification{}
+	mi := &file_game_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EntitySpawnNotification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntitySpawnNotification) ProtoMessage() {}
+
+func (x *EntitySpawnNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntitySpawnNotification.ProtoReflect.Descriptor instead.
+func (*EntitySpawnNotification) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *EntitySpawnNotification) GetEntities() []*Entity {
+	if x != nil {
+		return x.Entities
+	}
+	return nil
+}
+
+type EntityDespawnNotification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EntityIds []uint64 `protobuf:"varint,1,rep,packed,name=entityIds,proto3" json:"entityIds,omitempty"`
+}
+
+func (x *EntityDespawnNotification) Reset() {
+	*x = EntityDespawnNotification{}
+	mi := &file_game_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EntityDespawnNotification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntityDespawnNotification) ProtoMessage() {}
+
+func (x *EntityDespawnNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntityDespawnNotification.ProtoReflect.Descriptor instead.
+func (*EntityDespawnNotification) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *EntityDespawnNotification) GetEntityIds() []uint64 {
+	if x != nil {
+		return x.EntityIds
+	}
+	return nil
+}
+
+type EntityUpdateNotification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entities []*Entity `protobuf:"bytes,1,rep,name=entities,proto3" json:"entities,omitempty"`
+}
+
+func (x *EntityUpdateNotification) Reset() {
+	*x = EntityUpdateNotification{}
+	mi := &file_game_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EntityUpdateNotification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntityUpdateNotification) ProtoMessage() {}
+
+func (x *EntityUpdateNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntityUpdateNotification.ProtoReflect.Descriptor instead.
+func (*EntityUpdateNotification) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *EntityUpdateNotification) GetEntities() []*Entity {
+	if x != nil {
+		return x.Entities
+	}
+	return nil
+}
+
+// 房间内自定义消息, 新增玩法消息时不需要修改 MessageId
+// 客户端发给服务器时按 target 转发, 服务器转发时填写 senderId
+type RoomCustomMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Subtype         uint32      `protobuf:"varint,1,opt,name=subtype,proto3" json:"subtype,omitempty"` // 由游戏自行定义
+	Data            []byte      `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Target          RelayTarget `protobuf:"varint,3,opt,name=target,proto3,enum=game.RelayTarget" json:"target,omitempty"`
+	TargetPlayerIds []string    `protobuf:"bytes,4,rep,name=targetPlayerIds,proto3" json:"targetPlayerIds,omitempty"`
+	SenderId        string      `protobuf:"bytes,5,opt,name=senderId,proto3" json:"senderId,omitempty"`
+}
+
+func (x *RoomCustomMessage) Reset() {
+	*x = RoomCustomMessage{}
+	mi := &file_game_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoomCustomMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomCustomMessage) ProtoMessage() {}
+
+func (x *RoomCustomMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomCustomMessage.ProtoReflect.Descriptor instead.
+func (*RoomCustomMessage) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *RoomCustomMessage) GetSubtype() uint32 {
+	if x != nil {
+		return x.Subtype
+	}
+	return 0
+}
+
+func (x *RoomCustomMessage) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *RoomCustomMessage) GetTarget() RelayTarget {
+	if x != nil {
+		return x.Target
+	}
+	return RelayTarget_RELAY_NONE
+}
+
+func (x *RoomCustomMessage) GetTargetPlayerIds() []string {
+	if x != nil {
+		return x.TargetPlayerIds
+	}
+	return nil
+}
+
+func (x *RoomCustomMessage) GetSenderId() string {
+	if x != nil {
+		return x.SenderId
+	}
+	return ""
+}
+
+// 通用错误响应, 请求无法被正常处理时代替原响应发送
+type ErrorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MsgSerialNo int32     `protobuf:"varint,1,opt,name=msgSerialNo,proto3" json:"msgSerialNo,omitempty"` // 出错请求的序号
+	RequestId   MessageId `protobuf:"varint,2,opt,name=requestId,proto3,enum=game.MessageId" json:"requestId,omitempty"`
+	Code        ErrorCode `protobuf:"varint,3,opt,name=code,proto3,enum=game.ErrorCode" json:"code,omitempty"`
+	Message     string    `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ErrorResponse) Reset() {
+	*x = ErrorResponse{}
+	mi := &file_game_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ErrorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErrorResponse) ProtoMessage() {}
+
+func (x *ErrorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErrorResponse.ProtoReflect.Descriptor instead.
+func (*ErrorResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ErrorResponse) GetMsgSerialNo() int32 {
+	if x != nil {
+		return x.MsgSerialNo
+	}
+	return 0
+}
+
+func (x *ErrorResponse) GetRequestId() MessageId {
+	if x != nil {
+		return x.RequestId
 	}
-	return mi.MessageOf(x)
+	return MessageId_LOGIN_REQUEST
 }
 
-// Deprecated: Use CreateRoomRequest.ProtoReflect.Descriptor instead.
-func (*CreateRoomRequest) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{7}
+func (x *ErrorResponse) GetCode() ErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return ErrorCode_OK
 }
 
-func (x *CreateRoomRequest) GetName() string {
+func (x *ErrorResponse) GetMessage() string {
 	if x != nil {
-		return x.Name
+		return x.Message
 	}
 	return ""
 }
 
-type CreateRoomResponse struct {
+// 权威模拟房间中客户端每帧发送的输入, 无响应
+type PlayerInputRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Ret  ErrorCode `protobuf:"varint,1,opt,name=ret,proto3,enum=game.ErrorCode" json:"ret,omitempty"`
-	Room *Room     `protobuf:"bytes,2,opt,name=room,proto3" json:"room,omitempty"`
+	Seq       uint32    `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`            // 客户端输入序号, 递增
+	Direction *Position `protobuf:"bytes,2,opt,name=direction,proto3" json:"direction,omitempty"` // 移动方向, 服务器会归一化
+	Speed     float32   `protobuf:"fixed32,3,opt,name=speed,proto3" json:"speed,omitempty"`       // 期望速度, 不超过地图的最大速度
 }
 
-func (x *CreateRoomResponse) Reset() {
-	*x = CreateRoomResponse{}
-	mi := &file_game_proto_msgTypes[8]
+func (x *PlayerInputRequest) Reset() {
+	*x = PlayerInputRequest{}
+	mi := &file_game_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateRoomResponse) String() string {
+func (x *PlayerInputRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRoomResponse) ProtoMessage() {}
+func (*PlayerInputRequest) ProtoMessage() {}
 
-func (x *CreateRoomResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[8]
+func (x *PlayerInputRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -595,49 +2788,58 @@ func (x *CreateRoomResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRoomResponse.ProtoReflect.Descriptor instead.
-func (*CreateRoomResponse) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use PlayerInputRequest.ProtoReflect.Descriptor instead.
+func (*PlayerInputRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *CreateRoomResponse) GetRet() ErrorCode {
+func (x *PlayerInputRequest) GetSeq() uint32 {
 	if x != nil {
-		return x.Ret
+		return x.Seq
 	}
-	return ErrorCode_OK
+	return 0
 }
 
-func (x *CreateRoomResponse) GetRoom() *Room {
+func (x *PlayerInputRequest) GetDirection() *Position {
 	if x != nil {
-		return x.Room
+		return x.Direction
 	}
 	return nil
 }
 
-type JoinRoomRequest struct {
+func (x *PlayerInputRequest) GetSpeed() float32 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+// 移动被服务器拒绝, 客户端应回退到 position
+type MoveCorrectionNotification struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Player *Player `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
-	RoomId uint64  `protobuf:"varint,2,opt,name=roomId,proto3" json:"roomId,omitempty"`
+	Position   *Position `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
+	Reason     string    `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Violations int32     `protobuf:"varint,3,opt,name=violations,proto3" json:"violations,omitempty"` // 累计违规次数
 }
 
-func (x *JoinRoomRequest) Reset() {
-	*x = JoinRoomRequest{}
-	mi := &file_game_proto_msgTypes[9]
+func (x *MoveCorrectionNotification) Reset() {
+	*x = MoveCorrectionNotification{}
+	mi := &file_game_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *JoinRoomRequest) String() string {
+func (x *MoveCorrectionNotification) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JoinRoomRequest) ProtoMessage() {}
+func (*MoveCorrectionNotification) ProtoMessage() {}
 
-func (x *JoinRoomRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[9]
+func (x *MoveCorrectionNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -648,49 +2850,56 @@ func (x *JoinRoomRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JoinRoomRequest.ProtoReflect.Descriptor instead.
-func (*JoinRoomRequest) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use MoveCorrectionNotification.ProtoReflect.Descriptor instead.
+func (*MoveCorrectionNotification) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *JoinRoomRequest) GetPlayer() *Player {
+func (x *MoveCorrectionNotification) GetPosition() *Position {
 	if x != nil {
-		return x.Player
+		return x.Position
 	}
 	return nil
 }
 
-func (x *JoinRoomRequest) GetRoomId() uint64 {
+func (x *MoveCorrectionNotification) GetReason() string {
 	if x != nil {
-		return x.RoomId
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *MoveCorrectionNotification) GetViolations() int32 {
+	if x != nil {
+		return x.Violations
 	}
 	return 0
 }
 
-type JoinRoomResponse struct {
+// 服务器即将断开连接
+type KickNotification struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Ret  ErrorCode `protobuf:"varint,1,opt,name=ret,proto3,enum=game.ErrorCode" json:"ret,omitempty"`
-	Room *Room     `protobuf:"bytes,2,opt,name=room,proto3" json:"room,omitempty"`
+	Reason string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *JoinRoomResponse) Reset() {
-	*x = JoinRoomResponse{}
-	mi := &file_game_proto_msgTypes[10]
+func (x *KickNotification) Reset() {
+	*x = KickNotification{}
+	mi := &file_game_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *JoinRoomResponse) String() string {
+func (x *KickNotification) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JoinRoomResponse) ProtoMessage() {}
+func (*KickNotification) ProtoMessage() {}
 
-func (x *JoinRoomResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[10]
+func (x *KickNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -701,49 +2910,44 @@ func (x *JoinRoomResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JoinRoomResponse.ProtoReflect.Descriptor instead.
-func (*JoinRoomResponse) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *JoinRoomResponse) GetRet() ErrorCode {
-	if x != nil {
-		return x.Ret
-	}
-	return ErrorCode_OK
+// Deprecated: Use KickNotification.ProtoReflect.Descriptor instead.
+func (*KickNotification) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *JoinRoomResponse) GetRoom() *Room {
+func (x *KickNotification) GetReason() string {
 	if x != nil {
-		return x.Room
+		return x.Reason
 	}
-	return nil
+	return ""
 }
 
-type MoveRequest struct {
+// 服务器即将停止, 之后连接会被关闭
+type ServerShutdownNotification struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PlayerId string    `protobuf:"bytes,1,opt,name=playerId,proto3" json:"playerId,omitempty"`
-	Position *Position `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
+	Reason           string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	ReconnectAddr    string `protobuf:"bytes,2,opt,name=reconnectAddr,proto3" json:"reconnectAddr,omitempty"`        // 建议重连的地址, 为空表示没有可用的其他服务器
+	ReconnectDelayMs uint32 `protobuf:"varint,3,opt,name=reconnectDelayMs,proto3" json:"reconnectDelayMs,omitempty"` // 建议等待多久后重连
 }
 
-func (x *MoveRequest) Reset() {
-	*x = MoveRequest{}
-	mi := &file_game_proto_msgTypes[11]
+func (x *ServerShutdownNotification) Reset() {
+	*x = ServerShutdownNotification{}
+	mi := &file_game_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MoveRequest) String() string {
+func (x *ServerShutdownNotification) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MoveRequest) ProtoMessage() {}
+func (*ServerShutdownNotification) ProtoMessage() {}
 
-func (x *MoveRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[11]
+func (x *ServerShutdownNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -754,49 +2958,55 @@ func (x *MoveRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MoveRequest.ProtoReflect.Descriptor instead.
-func (*MoveRequest) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ServerShutdownNotification.ProtoReflect.Descriptor instead.
+func (*ServerShutdownNotification) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *MoveRequest) GetPlayerId() string {
+func (x *ServerShutdownNotification) GetReason() string {
 	if x != nil {
-		return x.PlayerId
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *MoveRequest) GetPosition() *Position {
+func (x *ServerShutdownNotification) GetReconnectAddr() string {
 	if x != nil {
-		return x.Position
+		return x.ReconnectAddr
 	}
-	return nil
+	return ""
 }
 
-type MoveResponse struct {
+func (x *ServerShutdownNotification) GetReconnectDelayMs() uint32 {
+	if x != nil {
+		return x.ReconnectDelayMs
+	}
+	return 0
+}
+
+type SwitchTeamRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Ret  ErrorCode `protobuf:"varint,1,opt,name=ret,proto3,enum=game.ErrorCode" json:"ret,omitempty"`
-	Room *Room     `protobuf:"bytes,2,opt,name=room,proto3" json:"room,omitempty"`
+	TeamId int32 `protobuf:"varint,1,opt,name=teamId,proto3" json:"teamId,omitempty"`
 }
 
-func (x *MoveResponse) Reset() {
-	*x = MoveResponse{}
-	mi := &file_game_proto_msgTypes[12]
+func (x *SwitchTeamRequest) Reset() {
+	*x = SwitchTeamRequest{}
+	mi := &file_game_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MoveResponse) String() string {
+func (x *SwitchTeamRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MoveResponse) ProtoMessage() {}
+func (*SwitchTeamRequest) ProtoMessage() {}
 
-func (x *MoveResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[12]
+func (x *SwitchTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -807,48 +3017,95 @@ func (x *MoveResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MoveResponse.ProtoReflect.Descriptor instead.
-func (*MoveResponse) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use SwitchTeamRequest.ProtoReflect.Descriptor instead.
+func (*SwitchTeamRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *MoveResponse) GetRet() ErrorCode {
+func (x *SwitchTeamRequest) GetTeamId() int32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+type SwitchTeamResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ret    ErrorCode `protobuf:"varint,1,opt,name=ret,proto3,enum=game.ErrorCode" json:"ret,omitempty"`
+	TeamId int32     `protobuf:"varint,2,opt,name=teamId,proto3" json:"teamId,omitempty"`
+}
+
+func (x *SwitchTeamResponse) Reset() {
+	*x = SwitchTeamResponse{}
+	mi := &file_game_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SwitchTeamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwitchTeamResponse) ProtoMessage() {}
+
+func (x *SwitchTeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwitchTeamResponse.ProtoReflect.Descriptor instead.
+func (*SwitchTeamResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SwitchTeamResponse) GetRet() ErrorCode {
 	if x != nil {
 		return x.Ret
 	}
 	return ErrorCode_OK
 }
 
-func (x *MoveResponse) GetRoom() *Room {
+func (x *SwitchTeamResponse) GetTeamId() int32 {
 	if x != nil {
-		return x.Room
+		return x.TeamId
 	}
-	return nil
+	return 0
 }
 
-type LeaveRoomRequest struct {
+type ChatRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PlayerId string `protobuf:"bytes,1,opt,name=playerId,proto3" json:"playerId,omitempty"`
+	Scope   ChatScope `protobuf:"varint,1,opt,name=scope,proto3,enum=game.ChatScope" json:"scope,omitempty"`
+	Content string    `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
 }
 
-func (x *LeaveRoomRequest) Reset() {
-	*x = LeaveRoomRequest{}
-	mi := &file_game_proto_msgTypes[13]
+func (x *ChatRequest) Reset() {
+	*x = ChatRequest{}
+	mi := &file_game_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LeaveRoomRequest) String() string {
+func (x *ChatRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LeaveRoomRequest) ProtoMessage() {}
+func (*ChatRequest) ProtoMessage() {}
 
-func (x *LeaveRoomRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[13]
+func (x *ChatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -859,42 +3116,48 @@ func (x *LeaveRoomRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LeaveRoomRequest.ProtoReflect.Descriptor instead.
-func (*LeaveRoomRequest) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use ChatRequest.ProtoReflect.Descriptor instead.
+func (*ChatRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *LeaveRoomRequest) GetPlayerId() string {
+func (x *ChatRequest) GetScope() ChatScope {
 	if x != nil {
-		return x.PlayerId
+		return x.Scope
+	}
+	return ChatScope_CHAT_ROOM
+}
+
+func (x *ChatRequest) GetContent() string {
+	if x != nil {
+		return x.Content
 	}
 	return ""
 }
 
-type LeaveRoomResponse struct {
+type ChatResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Ret  ErrorCode `protobuf:"varint,1,opt,name=ret,proto3,enum=game.ErrorCode" json:"ret,omitempty"`
-	Room *Room     `protobuf:"bytes,2,opt,name=room,proto3" json:"room,omitempty"`
+	Ret ErrorCode `protobuf:"varint,1,opt,name=ret,proto3,enum=game.ErrorCode" json:"ret,omitempty"`
 }
 
-func (x *LeaveRoomResponse) Reset() {
-	*x = LeaveRoomResponse{}
-	mi := &file_game_proto_msgTypes[14]
+func (x *ChatResponse) Reset() {
+	*x = ChatResponse{}
+	mi := &file_game_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LeaveRoomResponse) String() string {
+func (x *ChatResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LeaveRoomResponse) ProtoMessage() {}
+func (*ChatResponse) ProtoMessage() {}
 
-func (x *LeaveRoomResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[14]
+func (x *ChatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -905,48 +3168,45 @@ func (x *LeaveRoomResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LeaveRoomResponse.ProtoReflect.Descriptor instead.
-func (*LeaveRoomResponse) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ChatResponse.ProtoReflect.Descriptor instead.
+func (*ChatResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *LeaveRoomResponse) GetRet() ErrorCode {
+func (x *ChatResponse) GetRet() ErrorCode {
 	if x != nil {
 		return x.Ret
 	}
 	return ErrorCode_OK
 }
 
-func (x *LeaveRoomResponse) GetRoom() *Room {
-	if x != nil {
-		return x.Room
-	}
-	return nil
-}
-
-type RoomStateNotification struct {
+type ChatNotification struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Room *Room `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	PlayerId   string    `protobuf:"bytes,1,opt,name=playerId,proto3" json:"playerId,omitempty"`
+	PlayerName string    `protobuf:"bytes,2,opt,name=playerName,proto3" json:"playerName,omitempty"`
+	Scope      ChatScope `protobuf:"varint,3,opt,name=scope,proto3,enum=game.ChatScope" json:"scope,omitempty"`
+	TeamId     int32     `protobuf:"varint,4,opt,name=teamId,proto3" json:"teamId,omitempty"`
+	Content    string    `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
 }
 
-func (x *RoomStateNotification) Reset() {
-	*x = RoomStateNotification{}
-	mi := &file_game_proto_msgTypes[15]
+func (x *ChatNotification) Reset() {
+	*x = ChatNotification{}
+	mi := &file_game_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RoomStateNotification) String() string {
+func (x *ChatNotification) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RoomStateNotification) ProtoMessage() {}
+func (*ChatNotification) ProtoMessage() {}
 
-func (x *RoomStateNotification) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[15]
+func (x *ChatNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -957,16 +3217,44 @@ func (x *RoomStateNotification) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RoomStateNotification.ProtoReflect.Descriptor instead.
-func (*RoomStateNotification) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ChatNotification.ProtoReflect.Descriptor instead.
+func (*ChatNotification) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *RoomStateNotification) GetRoom() *Room {
+func (x *ChatNotification) GetPlayerId() string {
 	if x != nil {
-		return x.Room
+		return x.PlayerId
 	}
-	return nil
+	return ""
+}
+
+func (x *ChatNotification) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *ChatNotification) GetScope() ChatScope {
+	if x != nil {
+		return x.Scope
+	}
+	return ChatScope_CHAT_ROOM
+}
+
+func (x *ChatNotification) GetTeamId() int32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *ChatNotification) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
 }
 
 type Message struct {
@@ -982,7 +3270,7 @@ type Message struct {
 
 func (x *Message) Reset() {
 	*x = Message{}
-	mi := &file_game_proto_msgTypes[16]
+	mi := &file_game_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -994,7 +3282,7 @@ func (x *Message) String() string {
 func (*Message) ProtoMessage() {}
 
 func (x *Message) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[16]
+	mi := &file_game_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1007,7 +3295,7 @@ func (x *Message) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Message.ProtoReflect.Descriptor instead.
 func (*Message) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{16}
+	return file_game_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *Message) GetClientId() string {
@@ -1045,107 +3333,422 @@ var file_game_proto_rawDesc = []byte{
 	0x6d, 0x65, 0x22, 0x34, 0x0a, 0x08, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0c,
 	0x0a, 0x01, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01,
 	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x01, 0x79, 0x12, 0x0c, 0x0a, 0x01, 0x7a, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x01, 0x7a, 0x22, 0x58, 0x0a, 0x06, 0x50, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e,
-	0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x22, 0x52, 0x0a, 0x04, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x26,
-	0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x0c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x07, 0x70,
-	0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x22, 0x2e, 0x0a, 0x0c, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
-	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x2b, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65,
-	0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65,
-	0x72, 0x49, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5a, 0x0a, 0x13, 0x47, 0x65, 0x74,
-	0x52, 0x6f, 0x6f, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x21, 0x0a, 0x03, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e,
-	0x67, 0x61, 0x6d, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x03,
-	0x72, 0x65, 0x74, 0x12, 0x20, 0x0a, 0x05, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x05,
-	0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x22, 0x27, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
+	0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x01, 0x7a, 0x22, 0x94, 0x01, 0x0a, 0x06, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x67, 0x61, 0x6d, 0x65,
+	0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x6c,
+	0x61, 0x73, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x65, 0x71, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x65, 0x71, 0x22,
+	0x37, 0x0a, 0x0f, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
+	0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x68, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
+	0x68, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x61, 0x78, 0x48, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x6d, 0x61, 0x78, 0x48, 0x70, 0x22, 0x3f, 0x0a, 0x11, 0x56, 0x65, 0x6c, 0x6f,
+	0x63, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x2a, 0x0a,
+	0x08, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x08, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x22, 0x2c, 0x0a, 0x0e, 0x4f, 0x77, 0x6e,
+	0x65, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x22, 0x3d, 0x0a, 0x0f, 0x50, 0x69, 0x63, 0x6b, 0x75,
+	0x70, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x74,
+	0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xc1, 0x02, 0x0a, 0x06, 0x45, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x24, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x10, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x67, 0x61, 0x6d, 0x65,
+	0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x68, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x12, 0x33, 0x0a, 0x08, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x56, 0x65, 0x6c, 0x6f,
+	0x63, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x76,
+	0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x4f, 0x77,
+	0x6e, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x12, 0x2d, 0x0a, 0x06, 0x70, 0x69, 0x63, 0x6b, 0x75, 0x70, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x50, 0x69, 0x63, 0x6b, 0x75,
+	0x70, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x70, 0x69, 0x63, 0x6b,
+	0x75, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x06, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x22, 0xc8, 0x03, 0x0a, 0x04, 0x52,
+	0x6f, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12,
+	0x1c, 0x0a, 0x09, 0x74, 0x65, 0x61, 0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x74, 0x65, 0x61, 0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x74, 0x65, 0x61, 0x6d, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x74, 0x65, 0x61, 0x6d, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x63,
+	0x6b, 0x52, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x74, 0x69, 0x63,
+	0x6b, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x53, 0x79,
+	0x6e, 0x63, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x53,
+	0x79, 0x6e, 0x63, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6f, 0x69, 0x52, 0x61, 0x64, 0x69, 0x75, 0x73,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x61, 0x6f, 0x69, 0x52, 0x61, 0x64, 0x69, 0x75,
+	0x73, 0x12, 0x22, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x0e, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x4d, 0x6f, 0x64, 0x65, 0x52,
+	0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x44, 0x65,
+	0x6c, 0x61, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x69, 0x6e, 0x70, 0x75, 0x74,
+	0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x74, 0x61, 0x74, 0x69, 0x76, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x61, 0x74, 0x69, 0x76, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x61, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x61,
+	0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x72, 0x6f, 0x6f, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x72, 0x6f, 0x6f, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x49, 0x64, 0x22, 0x2e, 0x0a, 0x0c, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x2b, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x49, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5a, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x52,
+	0x6f, 0x6f, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x21, 0x0a, 0x03, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x67,
+	0x61, 0x6d, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x03, 0x72,
+	0x65, 0x74, 0x12, 0x20, 0x0a, 0x05, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0a, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x05, 0x72,
+	0x6f, 0x6f, 0x6d, 0x73, 0x22, 0xf1, 0x02, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
 	0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x57,
-	0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x03, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x0f, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f,
-	0x64, 0x65, 0x52, 0x03, 0x72, 0x65, 0x74, 0x12, 0x1e, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x52, 0x6f, 0x6f,
-	0x6d, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x22, 0x4f, 0x0a, 0x0f, 0x4a, 0x6f, 0x69, 0x6e, 0x52,
-	0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x70, 0x6c,
-	0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x67, 0x61, 0x6d,
-	0x65, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
-	0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x22, 0x55, 0x0a, 0x10, 0x4a, 0x6f, 0x69, 0x6e,
-	0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x03,
-	0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x67, 0x61, 0x6d, 0x65,
-	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x03, 0x72, 0x65, 0x74, 0x12,
-	0x1e, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e,
-	0x67, 0x61, 0x6d, 0x65, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x22,
-	0x55, 0x0a, 0x0b, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
-	0x0a, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x08, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x67,
-	0x61, 0x6d, 0x65, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x51, 0x0a, 0x0c, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x03, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x43, 0x6f, 0x64, 0x65, 0x52, 0x03, 0x72, 0x65, 0x74, 0x12, 0x1e, 0x0a, 0x04, 0x72, 0x6f, 0x6f,
-	0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x52,
-	0x6f, 0x6f, 0x6d, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x22, 0x2e, 0x0a, 0x10, 0x4c, 0x65, 0x61,
-	0x76, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
-	0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x22, 0x56, 0x0a, 0x11, 0x4c, 0x65, 0x61,
-	0x76, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x65, 0x61, 0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x74, 0x65, 0x61, 0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x74, 0x65, 0x61, 0x6d, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x74, 0x65, 0x61, 0x6d, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x61, 0x6d,
+	0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x63, 0x6b, 0x52, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x74, 0x69, 0x63, 0x6b, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x64, 0x65, 0x6c, 0x74, 0x61, 0x53, 0x79, 0x6e, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6f,
+	0x69, 0x52, 0x61, 0x64, 0x69, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x61,
+	0x6f, 0x69, 0x52, 0x61, 0x64, 0x69, 0x75, 0x73, 0x12, 0x22, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x52, 0x6f,
+	0x6f, 0x6d, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x69, 0x6e, 0x70, 0x75, 0x74, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x24, 0x0a, 0x0d,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x61, 0x74, 0x69, 0x76, 0x65, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0d, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x61, 0x74, 0x69,
+	0x76, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x61, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x72, 0x6f, 0x6f, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x72, 0x6f, 0x6f, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x22, 0x57, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21,
+	0x0a, 0x03, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x67, 0x61,
+	0x6d, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x03, 0x72, 0x65,
+	0x74, 0x12, 0x1e, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0a, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x04, 0x72, 0x6f, 0x6f,
+	0x6d, 0x22, 0x67, 0x0a, 0x0f, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f,
+	0x6f, 0x6d, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x22, 0x55, 0x0a, 0x10, 0x4a, 0x6f,
+	0x69, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21,
 	0x0a, 0x03, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x67, 0x61,
 	0x6d, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x03, 0x72, 0x65,
 	0x74, 0x12, 0x1e, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x0a, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x04, 0x72, 0x6f, 0x6f,
-	0x6d, 0x22, 0x37, 0x0a, 0x15, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x4e, 0x6f,
-	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x04, 0x72, 0x6f,
-	0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e,
-	0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x22, 0x7c, 0x0a, 0x07, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49,
-	0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x73, 0x67, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x4e, 0x6f,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6d, 0x73, 0x67, 0x53, 0x65, 0x72, 0x69, 0x61,
-	0x6c, 0x4e, 0x6f, 0x12, 0x1f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x0f, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x2a, 0x68, 0x0a, 0x09, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a,
-	0x0e, 0x52, 0x4f, 0x4f, 0x4d, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10,
-	0x01, 0x12, 0x0d, 0x0a, 0x09, 0x52, 0x4f, 0x4f, 0x4d, 0x5f, 0x46, 0x55, 0x4c, 0x4c, 0x10, 0x02,
-	0x12, 0x14, 0x0a, 0x10, 0x50, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46,
-	0x4f, 0x55, 0x4e, 0x44, 0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x4c, 0x41, 0x59, 0x45, 0x52,
-	0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x49, 0x4e, 0x5f, 0x52, 0x4f, 0x4f, 0x4d,
-	0x10, 0x04, 0x2a, 0xbe, 0x02, 0x0a, 0x09, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64,
-	0x12, 0x11, 0x0a, 0x0d, 0x4c, 0x4f, 0x47, 0x49, 0x4e, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53,
-	0x54, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4c, 0x4f, 0x47, 0x49, 0x4e, 0x5f, 0x52, 0x45, 0x53,
-	0x50, 0x4f, 0x4e, 0x53, 0x45, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x45, 0x54, 0x5f, 0x52,
-	0x4f, 0x4f, 0x4d, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54,
-	0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x47, 0x45, 0x54, 0x5f, 0x52, 0x4f, 0x4f, 0x4d, 0x5f, 0x4c,
-	0x49, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x10, 0x03, 0x12, 0x17,
-	0x0a, 0x13, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x4f, 0x4f, 0x4d, 0x5f, 0x52, 0x45,
-	0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0x04, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x52, 0x45, 0x41, 0x54,
-	0x45, 0x5f, 0x52, 0x4f, 0x4f, 0x4d, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x10,
-	0x05, 0x12, 0x15, 0x0a, 0x11, 0x4a, 0x4f, 0x49, 0x4e, 0x5f, 0x52, 0x4f, 0x4f, 0x4d, 0x5f, 0x52,
-	0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0x06, 0x12, 0x16, 0x0a, 0x12, 0x4a, 0x4f, 0x49, 0x4e,
-	0x5f, 0x52, 0x4f, 0x4f, 0x4d, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x10, 0x07,
-	0x12, 0x10, 0x0a, 0x0c, 0x4d, 0x4f, 0x56, 0x45, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54,
-	0x10, 0x08, 0x12, 0x11, 0x0a, 0x0d, 0x4d, 0x4f, 0x56, 0x45, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x4f,
-	0x4e, 0x53, 0x45, 0x10, 0x09, 0x12, 0x16, 0x0a, 0x12, 0x4c, 0x45, 0x41, 0x56, 0x45, 0x5f, 0x52,
-	0x4f, 0x4f, 0x4d, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0x0a, 0x12, 0x17, 0x0a,
-	0x13, 0x4c, 0x45, 0x41, 0x56, 0x45, 0x5f, 0x52, 0x4f, 0x4f, 0x4d, 0x5f, 0x52, 0x45, 0x53, 0x50,
-	0x4f, 0x4e, 0x53, 0x45, 0x10, 0x0b, 0x12, 0x1b, 0x0a, 0x17, 0x52, 0x4f, 0x4f, 0x4d, 0x5f, 0x53,
-	0x54, 0x41, 0x54, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f,
-	0x4e, 0x10, 0x0c, 0x42, 0x12, 0x5a, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x73, 0x72,
+	0x6d, 0x22, 0x55, 0x0a, 0x0b, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x08,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x51, 0x0a, 0x0c, 0x4d, 0x6f, 0x76, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x03, 0x72, 0x65, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x03, 0x72, 0x65, 0x74, 0x12, 0x1e, 0x0a, 0x04, 0x72,
+	0x6f, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x67, 0x61, 0x6d, 0x65,
+	0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x22, 0x2e, 0x0a, 0x10, 0x4c,
+	0x65, 0x61, 0x76, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x22, 0x56, 0x0a, 0x11, 0x4c,
+	0x65, 0x61, 0x76, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x21, 0x0a, 0x03, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e,
+	0x67, 0x61, 0x6d, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x03,
+	0x72, 0x65, 0x74, 0x12, 0x1e, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0a, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x04, 0x72,
+	0x6f, 0x6f, 0x6d, 0x22, 0x37, 0x0a, 0x15, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x04,
+	0x72, 0x6f, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x67, 0x61, 0x6d,
+	0x65, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x22, 0xb1, 0x01, 0x0a,
+	0x0b, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x67, 0x61, 0x6d,
+	0x65, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c,
+	0x6c, 0x61, 0x73, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x65, 0x71, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x65, 0x71,
+	0x22, 0xf3, 0x01, 0x0a, 0x16, 0x53, 0x74, 0x61, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x73,
+	0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x18, 0x0a,
+	0x07, 0x62, 0x61, 0x73, 0x65, 0x53, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
+	0x62, 0x61, 0x73, 0x65, 0x53, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x75, 0x6c, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x66, 0x75, 0x6c, 0x6c, 0x12, 0x2b, 0x0a, 0x07, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67,
+	0x61, 0x6d, 0x65, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x52,
+	0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x64, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x64, 0x12, 0x28, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x0f,
+	0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x45, 0x6e,
+	0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0x1c, 0x0a, 0x08, 0x53, 0x74, 0x61, 0x74, 0x65, 0x41,
+	0x63, 0x6b, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x03, 0x73, 0x65, 0x71, 0x22, 0x3e, 0x0a, 0x14, 0x41, 0x6f, 0x69, 0x45, 0x6e, 0x74, 0x65, 0x72,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x07,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x67, 0x61, 0x6d, 0x65, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x07, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x73, 0x22, 0x34, 0x0a, 0x14, 0x41, 0x6f, 0x69, 0x4c, 0x65, 0x61, 0x76, 0x65,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x73, 0x22, 0x2d, 0x0a, 0x11, 0x46, 0x72,
+	0x61, 0x6d, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x22, 0x42, 0x0a, 0x0a, 0x46, 0x72, 0x61,
+	0x6d, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x22, 0x4b, 0x0a,
+	0x05, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x49, 0x64,
+	0x12, 0x28, 0x0a, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x52, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x22, 0x38, 0x0a, 0x11, 0x46, 0x72,
+	0x61, 0x6d, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x23, 0x0a, 0x06, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0b, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x06, 0x66, 0x72,
+	0x61, 0x6d, 0x65, 0x73, 0x22, 0x33, 0x0a, 0x13, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x66,
+	0x72, 0x6f, 0x6d, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
+	0x66, 0x72, 0x6f, 0x6d, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x22, 0xa4, 0x01, 0x0a, 0x14, 0x46, 0x72,
+	0x61, 0x6d, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x21, 0x0a, 0x03, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x0f, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65,
+	0x52, 0x03, 0x72, 0x65, 0x74, 0x12, 0x23, 0x0a, 0x06, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x46, 0x72, 0x61,
+	0x6d, 0x65, 0x52, 0x06, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x6f, 0x6c, 0x64, 0x65, 0x73, 0x74, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6f, 0x6c, 0x64, 0x65, 0x73, 0x74, 0x46, 0x72, 0x61, 0x6d, 0x65,
+	0x22, 0x43, 0x0a, 0x17, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x70, 0x61, 0x77, 0x6e, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x08, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x67, 0x61, 0x6d, 0x65, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x08, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0x39, 0x0a, 0x19, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x44,
+	0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x73,
+	0x22, 0x44, 0x0a, 0x18, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x08,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x08, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0xb2, 0x01, 0x0a, 0x11, 0x52, 0x6f, 0x6f, 0x6d, 0x43,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x75, 0x62, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x73,
+	0x75, 0x62, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x29, 0x0a, 0x06, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x67, 0x61, 0x6d,
+	0x65, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x06, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0x9f, 0x01, 0x0a, 0x0d,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x6d, 0x73, 0x67, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x4e, 0x6f, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0b, 0x6d, 0x73, 0x67, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x4e, 0x6f, 0x12,
+	0x2d, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x49, 0x64, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x23,
+	0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x67,
+	0x61, 0x6d, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x6a, 0x0a,
+	0x12, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x2c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e,
+	0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x22, 0x80, 0x01, 0x0a, 0x1a, 0x4d, 0x6f,
+	0x76, 0x65, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x67, 0x61, 0x6d,
+	0x65, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a,
+	0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x2a, 0x0a, 0x10,
+	0x4b, 0x69, 0x63, 0x6b, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x86, 0x01, 0x0a, 0x1a, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x53, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x24, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x2a, 0x0a, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x4d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x10, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x4d,
+	0x73, 0x22, 0x2b, 0x0a, 0x11, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x54, 0x65, 0x61, 0x6d, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x22, 0x4f,
+	0x0a, 0x12, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x03, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x0f, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f,
+	0x64, 0x65, 0x52, 0x03, 0x72, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x22,
+	0x4e, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25,
+	0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e,
+	0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x05,
+	0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22,
+	0x31, 0x0a, 0x0c, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x21, 0x0a, 0x03, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x67,
+	0x61, 0x6d, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x03, 0x72,
+	0x65, 0x74, 0x22, 0xa7, 0x01, 0x0a, 0x10, 0x43, 0x68, 0x61, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x53, 0x63,
+	0x6f, 0x70, 0x65, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65,
+	0x61, 0x6d, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d,
+	0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x7c, 0x0a, 0x07,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x73, 0x67, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c,
+	0x4e, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6d, 0x73, 0x67, 0x53, 0x65, 0x72,
+	0x69, 0x61, 0x6c, 0x4e, 0x6f, 0x12, 0x1f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x0f, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x49, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x2a, 0x5a, 0x0a, 0x0a, 0x45, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x45, 0x4e, 0x54, 0x49,
+	0x54, 0x59, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a,
+	0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x4e, 0x50, 0x43, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11,
+	0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x50, 0x52, 0x4f, 0x4a, 0x45, 0x43, 0x54, 0x49, 0x4c,
+	0x45, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x50, 0x49,
+	0x43, 0x4b, 0x55, 0x50, 0x10, 0x03, 0x2a, 0x32, 0x0a, 0x08, 0x52, 0x6f, 0x6f, 0x6d, 0x4d, 0x6f,
+	0x64, 0x65, 0x12, 0x13, 0x0a, 0x0f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
+	0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x4d, 0x4f, 0x44, 0x45, 0x5f,
+	0x4c, 0x4f, 0x43, 0x4b, 0x53, 0x54, 0x45, 0x50, 0x10, 0x01, 0x2a, 0x66, 0x0a, 0x0b, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x0e, 0x0a, 0x0a, 0x46, 0x49, 0x45,
+	0x4c, 0x44, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x46, 0x49, 0x45,
+	0x4c, 0x44, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x46, 0x49, 0x45,
+	0x4c, 0x44, 0x5f, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x0e, 0x0a,
+	0x0a, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x54, 0x45, 0x41, 0x4d, 0x10, 0x04, 0x12, 0x13, 0x0a,
+	0x0f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x49, 0x4e, 0x50, 0x55, 0x54, 0x5f, 0x53, 0x45, 0x51,
+	0x10, 0x08, 0x2a, 0x62, 0x0a, 0x0b, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x54, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x45, 0x4c, 0x41, 0x59, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10,
+	0x00, 0x12, 0x0d, 0x0a, 0x09, 0x52, 0x45, 0x4c, 0x41, 0x59, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x01,
+	0x12, 0x10, 0x0a, 0x0c, 0x52, 0x45, 0x4c, 0x41, 0x59, 0x5f, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x53,
+	0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x52, 0x45, 0x4c, 0x41, 0x59, 0x5f, 0x50, 0x4c, 0x41, 0x59,
+	0x45, 0x52, 0x53, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x45, 0x4c, 0x41, 0x59, 0x5f, 0x4f,
+	0x57, 0x4e, 0x45, 0x52, 0x10, 0x04, 0x2a, 0x29, 0x0a, 0x09, 0x43, 0x68, 0x61, 0x74, 0x53, 0x63,
+	0x6f, 0x70, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x48, 0x41, 0x54, 0x5f, 0x52, 0x4f, 0x4f, 0x4d,
+	0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x48, 0x41, 0x54, 0x5f, 0x54, 0x45, 0x41, 0x4d, 0x10,
+	0x01, 0x2a, 0x90, 0x03, 0x0a, 0x09, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x52, 0x4f, 0x4f, 0x4d, 0x5f,
+	0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x52,
+	0x4f, 0x4f, 0x4d, 0x5f, 0x46, 0x55, 0x4c, 0x4c, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x4c,
+	0x41, 0x59, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x03,
+	0x12, 0x1a, 0x0a, 0x16, 0x50, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41,
+	0x44, 0x59, 0x5f, 0x49, 0x4e, 0x5f, 0x52, 0x4f, 0x4f, 0x4d, 0x10, 0x04, 0x12, 0x16, 0x0a, 0x12,
+	0x50, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x49, 0x4e, 0x5f, 0x52, 0x4f,
+	0x4f, 0x4d, 0x10, 0x05, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x45, 0x41, 0x4d, 0x5f, 0x4e, 0x4f, 0x54,
+	0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x06, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x45, 0x41, 0x4d,
+	0x5f, 0x46, 0x55, 0x4c, 0x4c, 0x10, 0x07, 0x12, 0x16, 0x0a, 0x12, 0x52, 0x4f, 0x4f, 0x4d, 0x5f,
+	0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4d, 0x49, 0x53, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x10, 0x08, 0x12,
+	0x11, 0x0a, 0x0d, 0x4d, 0x41, 0x50, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44,
+	0x10, 0x09, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x4f, 0x4f, 0x4d, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x0a, 0x12, 0x13, 0x0a, 0x0f, 0x49,
+	0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0x0b,
+	0x12, 0x12, 0x0a, 0x0e, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x45, 0x52, 0x52,
+	0x4f, 0x52, 0x10, 0x0c, 0x12, 0x13, 0x0a, 0x0f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f,
+	0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x10, 0x0d, 0x12, 0x11, 0x0a, 0x0d, 0x4e, 0x4f, 0x54,
+	0x5f, 0x4c, 0x4f, 0x47, 0x47, 0x45, 0x44, 0x5f, 0x49, 0x4e, 0x10, 0x0e, 0x12, 0x13, 0x0a, 0x0f,
+	0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10,
+	0x0f, 0x12, 0x14, 0x0a, 0x10, 0x52, 0x4f, 0x4f, 0x4d, 0x5f, 0x55, 0x4e, 0x41, 0x56, 0x41, 0x49,
+	0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x10, 0x12, 0x10, 0x0a, 0x0c, 0x52, 0x41, 0x54, 0x45, 0x5f,
+	0x4c, 0x49, 0x4d, 0x49, 0x54, 0x45, 0x44, 0x10, 0x11, 0x12, 0x19, 0x0a, 0x15, 0x46, 0x52, 0x41,
+	0x4d, 0x45, 0x5f, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52,
+	0x45, 0x44, 0x10, 0x12, 0x2a, 0xfc, 0x06, 0x0a, 0x09, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x49, 0x64, 0x12, 0x11, 0x0a, 0x0d, 0x4c, 0x4f, 0x47, 0x49, 0x4e, 0x5f, 0x52, 0x45, 0x51, 0x55,
+	0x45, 0x53, 0x54, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4c, 0x4f, 0x47, 0x49, 0x4e, 0x5f, 0x52,
+	0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x45, 0x54,
+	0x5f, 0x52, 0x4f, 0x4f, 0x4d, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45,
+	0x53, 0x54, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x47, 0x45, 0x54, 0x5f, 0x52, 0x4f, 0x4f, 0x4d,
+	0x5f, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x10, 0x03,
+	0x12, 0x17, 0x0a, 0x13, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x4f, 0x4f, 0x4d, 0x5f,
+	0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0x04, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x52, 0x45,
+	0x41, 0x54, 0x45, 0x5f, 0x52, 0x4f, 0x4f, 0x4d, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53,
+	0x45, 0x10, 0x05, 0x12, 0x15, 0x0a, 0x11, 0x4a, 0x4f, 0x49, 0x4e, 0x5f, 0x52, 0x4f, 0x4f, 0x4d,
+	0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0x06, 0x12, 0x16, 0x0a, 0x12, 0x4a, 0x4f,
+	0x49, 0x4e, 0x5f, 0x52, 0x4f, 0x4f, 0x4d, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45,
+	0x10, 0x07, 0x12, 0x10, 0x0a, 0x0c, 0x4d, 0x4f, 0x56, 0x45, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45,
+	0x53, 0x54, 0x10, 0x08, 0x12, 0x11, 0x0a, 0x0d, 0x4d, 0x4f, 0x56, 0x45, 0x5f, 0x52, 0x45, 0x53,
+	0x50, 0x4f, 0x4e, 0x53, 0x45, 0x10, 0x09, 0x12, 0x16, 0x0a, 0x12, 0x4c, 0x45, 0x41, 0x56, 0x45,
+	0x5f, 0x52, 0x4f, 0x4f, 0x4d, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0x0a, 0x12,
+	0x17, 0x0a, 0x13, 0x4c, 0x45, 0x41, 0x56, 0x45, 0x5f, 0x52, 0x4f, 0x4f, 0x4d, 0x5f, 0x52, 0x45,
+	0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x10, 0x0b, 0x12, 0x1b, 0x0a, 0x17, 0x52, 0x4f, 0x4f, 0x4d,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x10, 0x0c, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x57, 0x49, 0x54, 0x43, 0x48, 0x5f,
+	0x54, 0x45, 0x41, 0x4d, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0x0d, 0x12, 0x18,
+	0x0a, 0x14, 0x53, 0x57, 0x49, 0x54, 0x43, 0x48, 0x5f, 0x54, 0x45, 0x41, 0x4d, 0x5f, 0x52, 0x45,
+	0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x10, 0x0e, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x48, 0x41, 0x54,
+	0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0x0f, 0x12, 0x11, 0x0a, 0x0d, 0x43, 0x48,
+	0x41, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x10, 0x10, 0x12, 0x15, 0x0a,
+	0x11, 0x43, 0x48, 0x41, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x10, 0x11, 0x12, 0x1c, 0x0a, 0x18, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x45,
+	0x4c, 0x54, 0x41, 0x5f, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x10, 0x12, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x43, 0x4b, 0x10,
+	0x13, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x4f, 0x49, 0x5f, 0x45, 0x4e, 0x54, 0x45, 0x52, 0x5f, 0x4e,
+	0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x14, 0x12, 0x1a, 0x0a,
+	0x16, 0x41, 0x4f, 0x49, 0x5f, 0x4c, 0x45, 0x41, 0x56, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x49, 0x46,
+	0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x15, 0x12, 0x17, 0x0a, 0x13, 0x46, 0x52, 0x41,
+	0x4d, 0x45, 0x5f, 0x49, 0x4e, 0x50, 0x55, 0x54, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54,
+	0x10, 0x16, 0x12, 0x16, 0x0a, 0x12, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x49,
+	0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x17, 0x12, 0x19, 0x0a, 0x15, 0x46, 0x52,
+	0x41, 0x4d, 0x45, 0x5f, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x52, 0x45, 0x51, 0x55,
+	0x45, 0x53, 0x54, 0x10, 0x18, 0x12, 0x1a, 0x0a, 0x16, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x5f, 0x48,
+	0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x10,
+	0x19, 0x12, 0x20, 0x0a, 0x1c, 0x4d, 0x4f, 0x56, 0x45, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x10, 0x1a, 0x12, 0x15, 0x0a, 0x11, 0x4b, 0x49, 0x43, 0x4b, 0x5f, 0x4e, 0x4f, 0x54, 0x49,
+	0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x1b, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x4c,
+	0x41, 0x59, 0x45, 0x52, 0x5f, 0x49, 0x4e, 0x50, 0x55, 0x54, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45,
+	0x53, 0x54, 0x10, 0x1c, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x53,
+	0x50, 0x41, 0x57, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x10, 0x1d, 0x12, 0x1f, 0x0a, 0x1b, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x44, 0x45,
+	0x53, 0x50, 0x41, 0x57, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x10, 0x1e, 0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x55,
+	0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x10, 0x1f, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x4f, 0x4f, 0x4d, 0x5f, 0x43, 0x55, 0x53,
+	0x54, 0x4f, 0x4d, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x10, 0x20, 0x12, 0x12, 0x0a,
+	0x0e, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x10,
+	0x21, 0x12, 0x20, 0x0a, 0x1c, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52, 0x5f, 0x53, 0x48, 0x55, 0x54,
+	0x44, 0x4f, 0x57, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x10, 0x22, 0x42, 0x12, 0x5a, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x73, 0x72,
 	0x63, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
@@ -1161,51 +3764,115 @@ func file_game_proto_rawDescGZIP() []byte {
 	return file_game_proto_rawDescData
 }
 
-var file_game_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_game_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_game_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_game_proto_msgTypes = make([]protoimpl.MessageInfo, 47)
 var file_game_proto_goTypes = []any{
-	(ErrorCode)(0),                // 0: game.ErrorCode
-	(MessageId)(0),                // 1: game.MessageId
-	(*Position)(nil),              // 2: game.Position
-	(*Player)(nil),                // 3: game.Player
-	(*Room)(nil),                  // 4: game.Room
-	(*LoginRequest)(nil),          // 5: game.LoginRequest
-	(*LoginResponse)(nil),         // 6: game.LoginResponse
-	(*GetRoomListRequest)(nil),    // 7: game.GetRoomListRequest
-	(*GetRoomListResponse)(nil),   // 8: game.GetRoomListResponse
-	(*CreateRoomRequest)(nil),     // 9: game.CreateRoomRequest
-	(*CreateRoomResponse)(nil),    // 10: game.CreateRoomResponse
-	(*JoinRoomRequest)(nil),       // 11: game.JoinRoomRequest
-	(*JoinRoomResponse)(nil),      // 12: game.JoinRoomResponse
-	(*MoveRequest)(nil),           // 13: game.MoveRequest
-	(*MoveResponse)(nil),          // 14: game.MoveResponse
-	(*LeaveRoomRequest)(nil),      // 15: game.LeaveRoomRequest
-	(*LeaveRoomResponse)(nil),     // 16: game.LeaveRoomResponse
-	(*RoomStateNotification)(nil), // 17: game.RoomStateNotification
-	(*Message)(nil),               // 18: game.Message
+	(EntityType)(0),                    // 0: game.EntityType
+	(RoomMode)(0),                      // 1: game.RoomMode
+	(PlayerField)(0),                   // 2: game.PlayerField
+	(RelayTarget)(0),                   // 3: game.RelayTarget
+	(ChatScope)(0),                     // 4: game.ChatScope
+	(ErrorCode)(0),                     // 5: game.ErrorCode
+	(MessageId)(0),                     // 6: game.MessageId
+	(*Position)(nil),                   // 7: game.Position
+	(*Player)(nil),                     // 8: game.Player
+	(*HealthComponent)(nil),            // 9: game.HealthComponent
+	(*VelocityComponent)(nil),          // 10: game.VelocityComponent
+	(*OwnerComponent)(nil),             // 11: game.OwnerComponent
+	(*PickupComponent)(nil),            // 12: game.PickupComponent
+	(*Entity)(nil),                     // 13: game.Entity
+	(*Room)(nil),                       // 14: game.Room
+	(*LoginRequest)(nil),               // 15: game.LoginRequest
+	(*LoginResponse)(nil),              // 16: game.LoginResponse
+	(*GetRoomListRequest)(nil),         // 17: game.GetRoomListRequest
+	(*GetRoomListResponse)(nil),        // 18: game.GetRoomListResponse
+	(*CreateRoomRequest)(nil),          // 19: game.CreateRoomRequest
+	(*CreateRoomResponse)(nil),         // 20: game.CreateRoomResponse
+	(*JoinRoomRequest)(nil),            // 21: game.JoinRoomRequest
+	(*JoinRoomResponse)(nil),           // 22: game.JoinRoomResponse
+	(*MoveRequest)(nil),                // 23: game.MoveRequest
+	(*MoveResponse)(nil),               // 24: game.MoveResponse
+	(*LeaveRoomRequest)(nil),           // 25: game.LeaveRoomRequest
+	(*LeaveRoomResponse)(nil),          // 26: game.LeaveRoomResponse
+	(*RoomStateNotification)(nil),      // 27: game.RoomStateNotification
+	(*PlayerDelta)(nil),                // 28: game.PlayerDelta
+	(*StateDeltaNotification)(nil),     // 29: game.StateDeltaNotification
+	(*StateAck)(nil),                   // 30: game.StateAck
+	(*AoiEnterNotification)(nil),       // 31: game.AoiEnterNotification
+	(*AoiLeaveNotification)(nil),       // 32: game.AoiLeaveNotification
+	(*FrameInputRequest)(nil),          // 33: game.FrameInputRequest
+	(*FrameInput)(nil),                 // 34: game.FrameInput
+	(*Frame)(nil),                      // 35: game.Frame
+	(*FrameNotification)(nil),          // 36: game.FrameNotification
+	(*FrameHistoryRequest)(nil),        // 37: game.FrameHistoryRequest
+	(*FrameHistoryResponse)(nil),       // 38: game.FrameHistoryResponse
+	(*EntitySpawnNotification)(nil),    // 39: game.EntitySpawnNotification
+	(*EntityDespawnNotification)(nil),  // 40: game.EntityDespawnNotification
+	(*EntityUpdateNotification)(nil),   // 41: game.EntityUpdateNotification
+	(*RoomCustomMessage)(nil),          // 42: game.RoomCustomMessage
+	(*ErrorResponse)(nil),              // 43: game.ErrorResponse
+	(*PlayerInputRequest)(nil),         // 44: game.PlayerInputRequest
+	(*MoveCorrectionNotification)(nil), // 45: game.MoveCorrectionNotification
+	(*KickNotification)(nil),           // 46: game.KickNotification
+	(*ServerShutdownNotification)(nil), // 47: game.ServerShutdownNotification
+	(*SwitchTeamRequest)(nil),          // 48: game.SwitchTeamRequest
+	(*SwitchTeamResponse)(nil),         // 49: game.SwitchTeamResponse
+	(*ChatRequest)(nil),                // 50: game.ChatRequest
+	(*ChatResponse)(nil),               // 51: game.ChatResponse
+	(*ChatNotification)(nil),           // 52: game.ChatNotification
+	(*Message)(nil),                    // 53: game.Message
 }
 var file_game_proto_depIdxs = []int32{
-	2,  // 0: game.Player.position:type_name -> game.Position
-	3,  // 1: game.Room.players:type_name -> game.Player
-	0,  // 2: game.GetRoomListResponse.ret:type_name -> game.ErrorCode
-	4,  // 3: game.GetRoomListResponse.rooms:type_name -> game.Room
-	0,  // 4: game.CreateRoomResponse.ret:type_name -> game.ErrorCode
-	4,  // 5: game.CreateRoomResponse.room:type_name -> game.Room
-	3,  // 6: game.JoinRoomRequest.player:type_name -> game.Player
-	0,  // 7: game.JoinRoomResponse.ret:type_name -> game.ErrorCode
-	4,  // 8: game.JoinRoomResponse.room:type_name -> game.Room
-	2,  // 9: game.MoveRequest.position:type_name -> game.Position
-	0,  // 10: game.MoveResponse.ret:type_name -> game.ErrorCode
-	4,  // 11: game.MoveResponse.room:type_name -> game.Room
-	0,  // 12: game.LeaveRoomResponse.ret:type_name -> game.ErrorCode
-	4,  // 13: game.LeaveRoomResponse.room:type_name -> game.Room
-	4,  // 14: game.RoomStateNotification.room:type_name -> game.Room
-	1,  // 15: game.Message.id:type_name -> game.MessageId
-	16, // [16:16] is the sub-list for method output_type
-	16, // [16:16] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	7,  // 0: game.Player.position:type_name -> game.Position
+	7,  // 1: game.VelocityComponent.velocity:type_name -> game.Position
+	0,  // 2: game.Entity.type:type_name -> game.EntityType
+	7,  // 3: game.Entity.position:type_name -> game.Position
+	9,  // 4: game.Entity.health:type_name -> game.HealthComponent
+	10, // 5: game.Entity.velocity:type_name -> game.VelocityComponent
+	11, // 6: game.Entity.owner:type_name -> game.OwnerComponent
+	12, // 7: game.Entity.pickup:type_name -> game.PickupComponent
+	8,  // 8: game.Room.players:type_name -> game.Player
+	1,  // 9: game.Room.mode:type_name -> game.RoomMode
+	13, // 10: game.Room.entities:type_name -> game.Entity
+	5,  // 11: game.GetRoomListResponse.ret:type_name -> game.ErrorCode
+	14, // 12: game.GetRoomListResponse.rooms:type_name -> game.Room
+	1,  // 13: game.CreateRoomRequest.mode:type_name -> game.RoomMode
+	5,  // 14: game.CreateRoomResponse.ret:type_name -> game.ErrorCode
+	14, // 15: game.CreateRoomResponse.room:type_name -> game.Room
+	8,  // 16: game.JoinRoomRequest.player:type_name -> game.Player
+	5,  // 17: game.JoinRoomResponse.ret:type_name -> game.ErrorCode
+	14, // 18: game.JoinRoomResponse.room:type_name -> game.Room
+	7,  // 19: game.MoveRequest.position:type_name -> game.Position
+	5,  // 20: game.MoveResponse.ret:type_name -> game.ErrorCode
+	14, // 21: game.MoveResponse.room:type_name -> game.Room
+	5,  // 22: game.LeaveRoomResponse.ret:type_name -> game.ErrorCode
+	14, // 23: game.LeaveRoomResponse.room:type_name -> game.Room
+	14, // 24: game.RoomStateNotification.room:type_name -> game.Room
+	7,  // 25: game.PlayerDelta.position:type_name -> game.Position
+	28, // 26: game.StateDeltaNotification.players:type_name -> game.PlayerDelta
+	13, // 27: game.StateDeltaNotification.entities:type_name -> game.Entity
+	8,  // 28: game.AoiEnterNotification.players:type_name -> game.Player
+	34, // 29: game.Frame.inputs:type_name -> game.FrameInput
+	35, // 30: game.FrameNotification.frames:type_name -> game.Frame
+	5,  // 31: game.FrameHistoryResponse.ret:type_name -> game.ErrorCode
+	35, // 32: game.FrameHistoryResponse.frames:type_name -> game.Frame
+	13, // 33: game.EntitySpawnNotification.entities:type_name -> game.Entity
+	13, // 34: game.EntityUpdateNotification.entities:type_name -> game.Entity
+	3,  // 35: game.RoomCustomMessage.target:type_name -> game.RelayTarget
+	6,  // 36: game.ErrorResponse.requestId:type_name -> game.MessageId
+	5,  // 37: game.ErrorResponse.code:type_name -> game.ErrorCode
+	7,  // 38: game.PlayerInputRequest.direction:type_name -> game.Position
+	7,  // 39: game.MoveCorrectionNotification.position:type_name -> game.Position
+	5,  // 40: game.SwitchTeamResponse.ret:type_name -> game.ErrorCode
+	4,  // 41: game.ChatRequest.scope:type_name -> game.ChatScope
+	5,  // 42: game.ChatResponse.ret:type_name -> game.ErrorCode
+	4,  // 43: game.ChatNotification.scope:type_name -> game.ChatScope
+	6,  // 44: game.Message.id:type_name -> game.MessageId
+	45, // [45:45] is the sub-list for method output_type
+	45, // [45:45] is the sub-list for method input_type
+	45, // [45:45] is the sub-list for extension type_name
+	45, // [45:45] is the sub-list for extension extendee
+	0,  // [0:45] is the sub-list for field type_name
 }
 
 func init() { file_game_proto_init() }
@@ -1218,8 +3885,8 @@ func file_game_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_game_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   17,
+			NumEnums:      7,
+			NumMessages:   47,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
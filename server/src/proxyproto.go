@@ -0,0 +1,156 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/netip"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// PROXY 协议 v2 的 12 字节签名
+var proxyV2Signature = []byte("\r\n\r\n\x00\r\nQUIT\n")
+
+// v1 头部的最大长度, 包括结尾的 \r\n
+const proxyV1MaxLen = 107
+
+var errNotProxyHeader = errors.New("missing PROXY protocol header")
+
+// 解析过 PROXY 头的连接, RemoteAddr 返回头部中的客户端地址
+type proxyConn struct {
+	net.Conn
+	reader *bufio.Reader // 可能缓存了头部之后的数据
+	remote net.Addr      // 为空表示头部没有携带地址, 使用代理的地址
+}
+
+func (c *proxyConn) Read(b []byte) (int, error) {
+	return c.reader.Read(b)
+}
+
+func (c *proxyConn) RemoteAddr() net.Addr {
+	if c.remote != nil {
+		return c.remote
+	}
+	return c.Conn.RemoteAddr()
+}
+
+// 按 Config.ProxyProtocol 解析连接开头的 PROXY 头
+// 只有来自 Config.ProxyTrusted 的连接会被解析, 且必须携带头部; 其他连接原样返回
+func acceptProxyHeader(conn net.Conn) (net.Conn, error) {
+	if !Config.ProxyProtocol {
+		return conn, nil
+	}
+	if !containsAddr(Config.ProxyTrusted, remoteAddr(conn)) {
+		return conn, nil
+	}
+
+	if Config.ProxyHeaderTimeout > 0 {
+		conn.SetReadDeadline(time.Now().Add(Config.ProxyHeaderTimeout))
+		defer conn.SetReadDeadline(time.Time{})
+	}
+	reader := bufio.NewReaderSize(conn, 256)
+	remote, err := readProxyHeader(reader)
+	if err != nil {
+		return nil, err
+	}
+	return &proxyConn{Conn: conn, reader: reader, remote: remote}, nil
+}
+
+// 读取 v1 或 v2 头部, 返回客户端地址, LOCAL/UNKNOWN 时返回 nil
+func readProxyHeader(r *bufio.Reader) (net.Addr, error) {
+	sig, err := r.Peek(len(proxyV2Signature))
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case bytes.Equal(sig, proxyV2Signature):
+		return readProxyV2(r)
+	case bytes.HasPrefix(sig, []byte("PROXY ")):
+		return readProxyV1(r)
+	}
+	return nil, errNotProxyHeader
+}
+
+// v1: "PROXY TCP4|TCP6|UNKNOWN <src> <dst> <srcport> <dstport>\r\n"
+func readProxyV1(r *bufio.Reader) (net.Addr, error) {
+	var line []byte
+	for len(line) < proxyV1MaxLen {
+		b, err := r.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		line = append(line, b)
+		if b == '\n' {
+			break
+		}
+	}
+	if !bytes.HasSuffix(line, []byte("\r\n")) {
+		return nil, errors.New("PROXY v1 header too long or not terminated by CRLF")
+	}
+
+	fields := strings.Fields(string(line[:len(line)-2]))
+	if len(fields) >= 2 && fields[1] == "UNKNOWN" {
+		return nil, nil
+	}
+	if len(fields) != 6 || (fields[1] != "TCP4" && fields[1] != "TCP6") {
+		return nil, fmt.Errorf("invalid PROXY v1 header %q", line)
+	}
+	addr, err := netip.ParseAddr(fields[2])
+	if err != nil {
+		return nil, fmt.Errorf("invalid PROXY v1 source address: %v", err)
+	}
+	if addr.Is4() != (fields[1] == "TCP4") {
+		return nil, fmt.Errorf("PROXY v1 source address %s does not match %s", addr, fields[1])
+	}
+	port, err := strconv.ParseUint(fields[4], 10, 16)
+	if err != nil {
+		return nil, fmt.Errorf("invalid PROXY v1 source port: %v", err)
+	}
+	return net.TCPAddrFromAddrPort(netip.AddrPortFrom(addr.Unmap(), uint16(port))), nil
+}
+
+// v2: 签名, 版本/命令, 地址族/协议, 2 字节长度, 地址块和 TLV
+func readProxyV2(r *bufio.Reader) (net.Addr, error) {
+	header := make([]byte, 16)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, err
+	}
+	if header[12]>>4 != 2 {
+		return nil, fmt.Errorf("unsupported PROXY v2 version %d", header[12]>>4)
+	}
+	payload := make([]byte, binary.BigEndian.Uint16(header[14:16]))
+	if _, err := io.ReadFull(r, payload); err != nil {
+		return nil, err
+	}
+
+	switch header[12] & 0x0f {
+	case 0x0: // LOCAL, 代理自身的连接, 例如健康检查
+		return nil, nil
+	case 0x1: // PROXY
+	default:
+		return nil, fmt.Errorf("unsupported PROXY v2 command %d", header[12]&0x0f)
+	}
+
+	// 只使用 TCP/UDP over IPv4/IPv6 的源地址, 其他地址族按 LOCAL 处理
+	var addrLen int
+	switch header[13] >> 4 {
+	case 0x1:
+		addrLen = 4
+	case 0x2:
+		addrLen = 16
+	default:
+		return nil, nil
+	}
+	if len(payload) < addrLen*2+4 {
+		return nil, errors.New("PROXY v2 address block too short")
+	}
+	addr, _ := netip.AddrFromSlice(payload[:addrLen])
+	port := binary.BigEndian.Uint16(payload[addrLen*2:])
+	return net.TCPAddrFromAddrPort(netip.AddrPortFrom(addr.Unmap(), port)), nil
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"io"
+	"strings"
+	"testing"
+)
+
+// 拼出 v2 头部: 签名, 版本/命令, 地址族/协议, 长度, 地址块
+func proxyV2Header(verCmd, family byte, payload []byte) []byte {
+	header := append([]byte(nil), proxyV2Signature...)
+	header = append(header, verCmd, family)
+	header = binary.BigEndian.AppendUint16(header, uint16(len(payload)))
+	return append(header, payload...)
+}
+
+// IPv4 地址块: 源地址, 目的地址, 源端口, 目的端口
+func proxyV2Inet4(src, dst [4]byte, srcPort, dstPort uint16) []byte {
+	payload := append(src[:], dst[:]...)
+	payload = binary.BigEndian.AppendUint16(payload, srcPort)
+	return binary.BigEndian.AppendUint16(payload, dstPort)
+}
+
+func TestReadProxyHeader(t *testing.T) {
+	inet6 := make([]byte, 36)
+	inet6[15] = 1 // 源地址 ::1
+	inet6[31] = 2 // 目的地址 ::2
+	binary.BigEndian.PutUint16(inet6[32:], 4000)
+
+	tests := []struct {
+		name    string
+		input   []byte
+		want    string // 期望的客户端地址, 空表示没有地址
+		wantErr bool
+	}{
+		{"v1 tcp4", []byte("PROXY TCP4 192.0.2.1 198.51.100.1 56324 443\r\n"), "192.0.2.1:56324", false},
+		{"v1 tcp6", []byte("PROXY TCP6 2001:db8::1 2001:db8::2 56324 443\r\n"), "[2001:db8::1]:56324", false},
+		{"v1 unknown", []byte("PROXY UNKNOWN\r\n"), "", false},
+		{"v1 tcp4 with ipv6 source", []byte("PROXY TCP4 2001:db8::1 198.51.100.1 56324 443\r\n"), "", true},
+		{"v1 tcp6 with ipv4 source", []byte("PROXY TCP6 192.0.2.1 2001:db8::2 56324 443\r\n"), "", true},
+		{"v1 bad port", []byte("PROXY TCP4 192.0.2.1 198.51.100.1 70000 443\r\n"), "", true},
+		{"v1 missing fields", []byte("PROXY TCP4 192.0.2.1\r\n"), "", true},
+		{"v1 unterminated", []byte("PROXY TCP4 192.0.2.1 198.51.100.1 56324 443\n"), "", true},
+		{"v1 too long", []byte("PROXY TCP4 " + strings.Repeat("1", proxyV1MaxLen) + "\r\n"), "", true},
+		{"v1 truncated", []byte("PROXY TCP4 192.0.2.1"), "", true},
+		{"v2 proxy inet", proxyV2Header(0x21, 0x11, proxyV2Inet4([4]byte{192, 0, 2, 1}, [4]byte{198, 51, 100, 1}, 56324, 443)), "192.0.2.1:56324", false},
+		{"v2 proxy inet6", proxyV2Header(0x21, 0x21, inet6), "[::1]:4000", false},
+		{"v2 local", proxyV2Header(0x20, 0x00, nil), "", false},
+		{"v2 unix", proxyV2Header(0x21, 0x31, make([]byte, 216)), "", false},
+		{"v2 truncated address block", proxyV2Header(0x21, 0x11, []byte{192, 0, 2, 1}), "", true},
+		{"v2 truncated payload", proxyV2Header(0x21, 0x11, proxyV2Inet4([4]byte{192, 0, 2, 1}, [4]byte{}, 1, 2))[:20], "", true},
+		{"v2 bad version", proxyV2Header(0x11, 0x11, proxyV2Inet4([4]byte{192, 0, 2, 1}, [4]byte{}, 1, 2)), "", true},
+		{"v2 bad command", proxyV2Header(0x22, 0x11, proxyV2Inet4([4]byte{192, 0, 2, 1}, [4]byte{}, 1, 2)), "", true},
+		{"not a header", []byte("GET / HTTP/1.1\r\n\r\n"), "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// 头部之后的数据必须原样留给后续读取
+			r := bufio.NewReader(bytes.NewReader(append(append([]byte(nil), tt.input...), "payload"...)))
+			addr, err := readProxyHeader(r)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("got %v, want error", addr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := ""
+			if addr != nil {
+				got = addr.String()
+			}
+			if got != tt.want {
+				t.Fatalf("addr = %q, want %q", got, tt.want)
+			}
+			if rest, _ := io.ReadAll(r); string(rest) != "payload" {
+				t.Fatalf("remaining data = %q", rest)
+			}
+		})
+	}
+}
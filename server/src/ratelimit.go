@@ -0,0 +1,44 @@
+package main
+
+import "time"
+
+// 令牌桶, 不是并发安全的, 由调用方保证只在一个协程中使用
+type TokenBucket struct {
+	rate   float64 // 每秒补充的令牌数
+	burst  float64 // 桶容量
+	tokens float64
+	last   time.Time
+}
+
+// 创建令牌桶, 初始为满
+func NewTokenBucket(rate float64, burst int) *TokenBucket {
+	if burst < 1 {
+		burst = 1
+	}
+	return &TokenBucket{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// 取一个令牌, 桶空时返回 false
+func (b *TokenBucket) Allow() bool {
+	return b.AllowAt(time.Now())
+}
+
+func (b *TokenBucket) AllowAt(now time.Time) bool {
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens += elapsed.Seconds() * b.rate
+		if b.tokens > b.burst {
+			b.tokens = b.burst
+		}
+		b.last = now
+	}
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
@@ -1,20 +1,63 @@
 package main
 
 import (
+	"fmt"
 	"log"
+	"runtime/debug"
 
 	"google.golang.org/protobuf/proto"
 	pb "server/src/proto"
 	"sync"
+	"time"
 )
 
+// 房间配置, 创建房间时由 CreateRoomRequest 指定
+type RoomConfig struct {
+	TeamCount int32   // 队伍数量, 0 表示不分队
+	TeamSize  int32   // 每队人数上限, 0 表示不限
+	TickRate  int32   // 逻辑帧率(Hz), 0 表示事件驱动, 每次移动立即广播
+	DeltaSync bool    // 按客户端确认的快照发送增量状态
+	AoiRadius float32 // 视野半径, 0 表示所有玩家互相可见
+
+	Mode       pb.RoomMode // 同步模式
+	InputDelay int32       // 帧同步输入延迟(帧)
+
+	Authoritative bool     // 服务器权威移动模拟, 客户端只发送输入
+	Map           *GameMap // 权威模拟使用的静态地图
+
+	RoomType string // 游戏模式, 决定使用哪个 RoomLogic
+}
+
 type Room struct {
-	ID        uint64
-	Name      string
+	ID   uint64
+	Name string
+	RoomConfig
 	Players   map[string]*Player
-	EventChan chan *Event // 房间消息管道
-	QuitChan  chan bool   // 退出信号
-	Mutex     sync.Mutex  // 保护 Players
+	OwnerId   string        // 房主
+	EventChan chan *Event   // 房间消息管道
+	QuitChan  chan bool     // 退出信号
+	done      chan struct{} // 房间协程退出时关闭
+	Mutex     sync.Mutex    // 保护 Players
+
+	Logic         RoomLogic // 游戏模式逻辑
+	pendingInputs []*Event  // 帧模式下累积的移动输入, 下一帧统一应用
+	tickStats     TickStats
+
+	stateSeq    uint32                 // 最新快照序号
+	clientSyncs map[string]*clientSync // 各客户端的同步状态
+
+	aoi   *AOIGrid                       // 视野网格, 未开启视野过滤时为 nil
+	views map[string]map[string]struct{} // 各玩家当前可见的其他玩家
+
+	lockstep *lockstepState // 帧同步状态, 非帧同步房间为 nil
+
+	Entities      map[uint64]*Entity // 非玩家实体, 只在房间协程中访问
+	nextEntityId  uint64
+	entityChanges entityChanges // 尚未通知客户端的实体变化
+
+	timers *TimerWheel // 房间定时器, 回调在房间协程中执行
+
+	closing bool // 出错后正在关闭, 只处理玩家离开
 }
 
 type RoomMessage struct {
@@ -23,117 +66,263 @@ type RoomMessage struct {
 }
 
 // 创建一个房间
-func NewRoom(id uint64, name string) *Room {
-	return &Room{
-		ID:        id,
-		Name:      name,
-		Players:   make(map[string]*Player),
-		EventChan: make(chan *Event, 100),
-		QuitChan:  make(chan bool),
+func NewRoom(id uint64, name string, config RoomConfig) *Room {
+	var aoi *AOIGrid
+	if config.AoiRadius > 0 {
+		aoi = NewAOIGrid(config.AoiRadius)
+	}
+	var lockstep *lockstepState
+	if config.Mode == pb.RoomMode_MODE_LOCKSTEP {
+		lockstep = newLockstepState()
+		if config.TickRate <= 0 {
+			config.TickRate = DefaultLockstepRate
+		}
+	}
+	if config.Authoritative && lockstep == nil {
+		if config.TickRate <= 0 {
+			config.TickRate = DefaultSimulationRate
+		}
+		if config.Map == nil {
+			config.Map = newEmptyMap()
+		}
+	} else {
+		config.Authoritative = false
+	}
+	if config.RoomType == "" {
+		config.RoomType = DefaultRoomType
+	}
+	logic, ok := RoomLogics.Create(config.RoomType)
+	if !ok {
+		logic = BaseRoomLogic{}
+	}
+	if config.InputDelay < 0 {
+		config.InputDelay = 0
+	}
+	config.TeamCount = min(max(config.TeamCount, 0), MaxTeamCount)
+	config.TeamSize = min(max(config.TeamSize, 0), MaxTeamSize)
+	if config.TickRate < 0 {
+		config.TickRate = 0
+	} else if config.TickRate > MaxTickRate {
+		config.TickRate = MaxTickRate
+	}
+	room := &Room{
+		ID:          id,
+		Name:        name,
+		RoomConfig:  config,
+		Logic:       logic,
+		Players:     make(map[string]*Player),
+		clientSyncs: make(map[string]*clientSync),
+		aoi:         aoi,
+		views:       make(map[string]map[string]struct{}),
+		lockstep:    lockstep,
+		Entities:    make(map[uint64]*Entity),
+		timers:      NewTimerWheel(),
+		EventChan:   make(chan *Event, 100),
+		QuitChan:    make(chan bool),
+		done:        make(chan struct{}),
 	}
+	room.resetEntityChanges()
+	return room
 }
 
 // 启动房间协程
 func (r *Room) Run() {
 	log.Printf("Room %s is running...\n", r.Name)
+	defer close(r.done)
+	r.Logic.OnCreate(r)
+
+	if r.TickRate > 0 {
+		log.Printf("Room %s ticking at %d Hz", r.Name, r.TickRate)
+	}
+	var ticker *time.Ticker
+	defer func() {
+		if ticker != nil {
+			ticker.Stop()
+		}
+	}()
+
 	for {
+		// 事件驱动模式或房间没有玩家时 tickChan 为 nil, 对应分支永远不会触发
+		ticker = r.updateTicker(ticker)
+		var tickChan <-chan time.Time
+		if ticker != nil {
+			tickChan = ticker.C
+		}
+
 		select {
 		case event := <-r.EventChan:
-			EventHandler.Handle(r, event)
+			r.handleEvent(event)
+		case <-tickChan:
+			if !r.closing {
+				r.safeCall("tick", func() { r.tick(r.TickInterval()) })
+			}
+		case now := <-r.timers.C():
+			if !r.closing {
+				r.timers.AdvanceTo(now, func(fn func()) {
+					if !r.closing {
+						r.safeCall("timer", fn)
+					}
+				})
+			}
 		case <-r.QuitChan:
-			log.Printf("Room %s is closing...", r.Name)
+			r.close()
 			return
 		}
+
+		if r.closing && len(r.Players) == 0 {
+			r.close()
+			return
+		}
+	}
+}
+
+// 只在有玩家时运行帧循环, 空房间不推进帧, 也不累积帧同步历史
+func (r *Room) updateTicker(ticker *time.Ticker) *time.Ticker {
+	interval := r.TickInterval()
+	switch {
+	case ticker == nil && interval > 0 && len(r.Players) > 0:
+		return time.NewTicker(interval)
+	case ticker != nil && len(r.Players) == 0:
+		ticker.Stop()
+		return nil
 	}
+	return ticker
+}
+
+func (r *Room) close() {
+	log.Printf("Room %s is closing...", r.Name)
+	r.safeCall("OnClose", func() { r.Logic.OnClose(r) })
+	r.timers.Stop()
 }
 
-//// 处理房间内的消息
-//func (r *Room) HandleMessage(msg *RoomMessage) {
-//	switch msg.Message.Id {
-//	case pb.MessageId_MOVE_REQUEST:
-//		// 解析消息
-//		var req pb.MoveRequest
-//		if err := proto.Unmarshal(msg.Message.Data, &req); err != nil {
-//			log.Println("Failed to parse MoveRequest:", err)
-//			return
-//		}
-//
-//		// 更新玩家位置
-//		player := r.Players[msg.PlayerID]
-//		player.Position = req.Position
-//		log.Printf("Player %s moved to %+v", player.Name, player.Position)
-//
-//		// 广播给其他玩家
-//		response := &pb.Message{
-//			Id: pb.MessageId_MOVE_RESPONSE,
-//			Data: mustMarshal(&pb.MoveResponse{
-//				Ret:  0,
-//				Room: &pb.Room{Id: r.ID, Name: r.Name},
-//			}),
-//		}
-//		r.Broadcast(msg.PlayerID, response)
-//	}
-//}
-
-// HandleMoveRequest 处理移动请求
-func (r *Room) HandleMoveRequest(msg *pb.Message) {
-	var req pb.MoveRequest
-	if err := proto.Unmarshal(msg.GetData(), &req); err != nil {
-		log.Println("Failed to parse MoveRequest:", err)
+// 房间协程退出时关闭的通道
+func (r *Room) Done() <-chan struct{} {
+	return r.done
+}
+
+// 处理一个事件, 处理器 panic 时通知等待方
+func (r *Room) handleEvent(event *Event) {
+	if r.closing && event.Type != EventLeaveRoom {
+		event.Fail(NewGameError(pb.ErrorCode_ROOM_UNAVAILABLE, "room %s is closing", r.Name))
+		return
+	}
+	// 请求方已经放弃等待或玩家已退出, 不再处理
+	if event.Ctx != nil && event.Ctx.Err() != nil && event.Type != EventLeaveRoom {
+		log.Printf("Room %s dropped event %d from player %s: %v", r.Name, event.Type, event.PlayerId, event.Ctx.Err())
 		return
 	}
+	if !r.safeCall(fmt.Sprintf("event %d from player %s", event.Type, event.PlayerId), func() { EventHandler.Handle(r, event) }) {
+		event.Fail(NewGameError(pb.ErrorCode_INTERNAL_ERROR, ""))
+	}
+}
 
-	// 更新玩家位置
-	player := r.Players[req.PlayerId]
-	player.Position = req.Position
-	log.Printf("Player %s moved to %+v", player.Name, player.Position)
+// 在房间协程中执行 fn 并捕获 panic, 只影响当前房间; 发生 panic 时返回 false
+func (r *Room) safeCall(what string, fn func()) (ok bool) {
+	defer func() {
+		if err := recover(); err != nil {
+			log.Printf("Panic in room %d (%s) handling %s: %v\n%s", r.ID, r.Name, what, err, debug.Stack())
+			ok = false
+			if Config.PanicCloseRoom {
+				r.shutdown("internal error")
+			}
+		}
+	}()
+	fn()
+	return true
+}
 
-	noti := &pb.Message{
-		Id:          pb.MessageId_ROOM_STATE_NOTIFICATION,
-		MsgSerialNo: -1,
-		ClientId:    msg.ClientId,
-		Data: mustMarshal(&pb.RoomStateNotification{
-			Room: r.FillRoomMsg(),
-		}),
+// 关闭出错的房间: 从房间列表移除并踢出所有玩家, 玩家全部离开后房间协程退出
+func (r *Room) shutdown(reason string) {
+	if r.closing {
+		return
 	}
+	r.closing = true
+	GlobalManager.RemoveRoom(r.ID)
 
-	r.Broadcast(req.PlayerId, noti)
+	// 出错的处理器可能还持有锁, 这里不加锁; Players 只在房间协程中修改
+	for _, player := range r.Players {
+		player.Kick("room closed: " + reason)
+	}
 }
 
 func (r *Room) FillRoomMsg() *pb.Room {
 	room := &pb.Room{
-		Id:   r.ID,
-		Name: r.Name,
+		Id:            r.ID,
+		Name:          r.Name,
+		TeamCount:     r.TeamCount,
+		TeamSize:      r.TeamSize,
+		TickRate:      r.TickRate,
+		DeltaSync:     r.DeltaSync,
+		AoiRadius:     r.AoiRadius,
+		Mode:          r.Mode,
+		InputDelay:    r.InputDelay,
+		Authoritative: r.Authoritative,
+		RoomType:      r.RoomType,
+		OwnerId:       r.OwnerId,
+	}
+	if r.Map != nil {
+		room.MapName = r.Map.Name
 	}
 	room.Players = make([]*pb.Player, 0)
 	for _, player := range r.Players {
-		room.Players = append(room.Players, &pb.Player{
-			Id:       player.Id,
-			Name:     player.Name,
-			Position: player.Position,
-		})
+		room.Players = append(room.Players, player.ToProto())
+	}
+	for _, e := range r.Entities {
+		room.Entities = append(room.Entities, e.ToProto())
 	}
 	return room
 }
 
 // 广播消息给所有玩家（排除发送者）
 func (r *Room) Broadcast(excludePlayerID string, msg *pb.Message) {
+	// 只编码一次, 所有玩家共享同一份数据
+	frame := mustEncodeFrame(msg)
+
 	r.Mutex.Lock()
 	defer r.Mutex.Unlock()
 	for id, player := range r.Players {
 		if id != excludePlayerID {
-			player.SendMessage(msg)
+			player.SendFrame(frame)
 		}
 	}
 }
 
-// 添加玩家
-func (r *Room) AddPlayer(player *Player) {
+// 添加玩家, teamId 为 NoTeam 时自动分配队伍
+func (r *Room) AddPlayer(player *Player, teamId int32) pb.ErrorCode {
 	r.Mutex.Lock()
 	defer r.Mutex.Unlock()
+	teamId, ret := r.pickTeam(player, teamId)
+	if ret != pb.ErrorCode_OK {
+		log.Printf("Player %s failed to join room %s, ret: %d", player.Name, r.Name, ret)
+		return ret
+	}
 	r.Players[player.Id] = player
-	player.Room = r
-	log.Printf("Player %s joined room %s", player.Name, r.Name)
+	if r.OwnerId == "" {
+		r.OwnerId = player.Id
+	}
+	player.TeamId = teamId
+	if r.Authoritative {
+		player.Position = &pb.Position{X: r.Map.Spawn[0], Y: r.Map.Spawn[1], Z: r.Map.Spawn[2]}
+		player.kinematic = kinematicState{}
+	}
+	player.acceptMove(player.Position, time.Now())
+	log.Printf("Player %s joined room %s, team %d", player.Name, r.Name, teamId)
+	return pb.ErrorCode_OK
+}
+
+// 房主离开时转给房间内任意一名玩家, 调用方需持有 r.Mutex
+func (r *Room) transferOwner() {
+	if _, ok := r.Players[r.OwnerId]; ok {
+		return
+	}
+	r.OwnerId = ""
+	for id := range r.Players {
+		r.OwnerId = id
+		break
+	}
+	if r.OwnerId != "" {
+		log.Printf("Room %s owner changed to %s", r.Name, r.OwnerId)
+	}
 }
 
 // mustMarshal marshals a protobuf message and logs a fatal error if it fails.
@@ -145,6 +334,14 @@ func mustMarshal(pb proto.Message) []byte {
 	return data
 }
 
+func mustEncodeFrame(msg *pb.Message) *Frame {
+	frame, err := EncodeFrame(msg)
+	if err != nil {
+		log.Fatalf("Failed to encode message %s: %v", msg.GetId(), err)
+	}
+	return frame
+}
+
 func (r *Room) HandleJoinRoom(event *Event) {
 	player, ok := GlobalManager.GetPlayer(event.PlayerId)
 	if !ok {
@@ -152,60 +349,118 @@ func (r *Room) HandleJoinRoom(event *Event) {
 		return
 	}
 
-	r.AddPlayer(player)
-	// 广播给其他玩家
-	noti := &pb.Message{
-		Id:          pb.MessageId_ROOM_STATE_NOTIFICATION,
-		MsgSerialNo: -1,
-		ClientId:    "",
-		Data: mustMarshal(&pb.RoomStateNotification{
-			Room: r.FillRoomMsg(),
-		}),
+	req := event.Payload.(*pb.JoinRoomRequest)
+	if ret := r.AddPlayer(player, req.TeamId); ret != pb.ErrorCode_OK {
+		event.ResponseChan <- &pb.JoinRoomResponse{
+			Ret:  ret,
+			Room: &pb.Room{Id: r.ID, Name: r.Name},
+		}
+		return
 	}
-	r.Broadcast(player.Id, noti)
+	r.Logic.OnPlayerJoin(r, player)
+
+	// 广播给其他玩家
+	r.BroadcastState(player.Id)
 
 	event.ResponseChan <- &pb.JoinRoomResponse{
 		Ret:  0,
-		Room: r.FillRoomMsg(),
+		Room: r.FillRoomMsgFor(player.Id),
 	}
 }
 func (r *Room) HandleLeaveRoom(event *Event) {
-	// 更新玩家位置
-	player := r.Players[event.PlayerId]
+	player, ok := r.Players[event.PlayerId]
+	if !ok {
+		if event.ResponseChan != nil {
+			event.ResponseChan <- &pb.LeaveRoomResponse{Ret: pb.ErrorCode_PLAYER_NOT_IN_ROOM}
+		}
+		return
+	}
 
 	log.Printf("Player %s left room %s", player.Name, r.Name)
 
+	r.Mutex.Lock()
 	delete(r.Players, event.PlayerId)
+	delete(r.clientSyncs, event.PlayerId)
+	r.transferOwner()
+	r.Mutex.Unlock()
+	player.TeamId = NoTeam
+	r.Logic.OnPlayerLeave(r, player)
+
+	r.BroadcastState(event.PlayerId)
+
+	if event.ResponseChan != nil {
+		event.ResponseChan <- &pb.LeaveRoomResponse{Ret: pb.ErrorCode_OK}
+	}
+}
+func (r *Room) HandleChat(event *Event) {
+	player, ok := r.Players[event.PlayerId]
+	if !ok {
+		log.Printf("Player %s not in room %s, chat dropped", event.PlayerId, r.Name)
+		return
+	}
 
+	req := event.Payload.(*pb.ChatRequest)
 	noti := &pb.Message{
-		Id:          pb.MessageId_ROOM_STATE_NOTIFICATION,
+		Id:          pb.MessageId_CHAT_NOTIFICATION,
 		MsgSerialNo: -1,
 		ClientId:    "",
-		Data: mustMarshal(&pb.RoomStateNotification{
-			Room: r.FillRoomMsg(),
+		Data: mustMarshal(&pb.ChatNotification{
+			PlayerId:   player.Id,
+			PlayerName: player.Name,
+			Scope:      req.Scope,
+			TeamId:     player.TeamId,
+			Content:    req.Content,
 		}),
 	}
 
-	r.Broadcast(event.PlayerId, noti)
-}
-func (r *Room) HandleChat(event *Event) {
-
+	// 队伍频道只发给同队玩家, 未分队时退化为房间频道
+	if req.Scope == pb.ChatScope_CHAT_TEAM && player.TeamId != NoTeam {
+		r.BroadcastToTeam(player.TeamId, "", noti)
+	} else {
+		r.Broadcast("", noti)
+	}
 }
 func (r *Room) HandleMove(event *Event) {
-	// 更新玩家位置
-	player := r.Players[event.PlayerId]
-	player.Position = event.Payload.(*pb.MoveRequest).Position
+	player, ok := r.Players[event.PlayerId]
+	if !ok {
+		return
+	}
+	if r.Authoritative {
+		log.Printf("Player %s sent MoveRequest in authoritative room %s, ignored", player.Id, r.Name)
+		return
+	}
+	if r.IsLockstep() {
+		log.Printf("Player %s sent MoveRequest in lockstep room %s, ignored", player.Id, r.Name)
+		return
+	}
 
-	log.Printf("Player %s moved to %+v", player.Name, player.Position)
+	// 以服务器接收时间校验, 帧模式下也在收到时校验而不是应用时
+	req := event.Payload.(*pb.MoveRequest)
+	now := time.Now()
+	if reason := r.validateMove(player, req.Position, now); reason != "" {
+		r.rejectMove(player, reason)
+		return
+	}
+	player.acceptMove(req.Position, now)
 
-	noti := &pb.Message{
-		Id:          pb.MessageId_ROOM_STATE_NOTIFICATION,
-		MsgSerialNo: -1,
-		ClientId:    "",
-		Data: mustMarshal(&pb.RoomStateNotification{
-			Room: r.FillRoomMsg(),
-		}),
+	// 帧模式下先累积输入, 由 tick 统一应用并广播
+	if r.TickRate > 0 {
+		r.pendingInputs = append(r.pendingInputs, event)
+		return
 	}
 
-	r.Broadcast(event.PlayerId, noti)
+	r.applyMove(event)
+
+	r.BroadcastMove(event.PlayerId)
+}
+
+// 更新玩家位置
+func (r *Room) applyMove(event *Event) {
+	player, ok := r.Players[event.PlayerId]
+	if !ok {
+		return
+	}
+	player.Position = event.Payload.(*pb.MoveRequest).Position
+
+	log.Printf("Player %s moved to %+v", player.Name, player.Position)
 }
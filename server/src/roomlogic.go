@@ -0,0 +1,92 @@
+package main
+
+import (
+	"log"
+	"time"
+
+	pb "server/src/proto"
+)
+
+// 游戏模式逻辑, 每个房间一个实例, 所有回调都在房间协程中执行
+type RoomLogic interface {
+	OnCreate(room *Room)
+	OnPlayerJoin(room *Room, player *Player)
+	OnPlayerLeave(room *Room, player *Player)
+	// 处理框架未注册处理器的消息, 返回 false 表示不认识该消息
+	OnMessage(room *Room, player *Player, msg *pb.Message) bool
+	// 仅在 TickRate > 0 且有玩家的房间中每帧调用
+	OnTick(room *Room, dt time.Duration)
+	OnClose(room *Room)
+}
+
+// 空实现, 游戏逻辑可以嵌入它只实现关心的回调
+type BaseRoomLogic struct{}
+
+func (BaseRoomLogic) OnCreate(room *Room)                                        {}
+func (BaseRoomLogic) OnPlayerJoin(room *Room, player *Player)                    {}
+func (BaseRoomLogic) OnPlayerLeave(room *Room, player *Player)                   {}
+func (BaseRoomLogic) OnMessage(room *Room, player *Player, msg *pb.Message) bool { return false }
+func (BaseRoomLogic) OnTick(room *Room, dt time.Duration)                        {}
+func (BaseRoomLogic) OnClose(room *Room)                                         {}
+
+// 默认房间类型
+const DefaultRoomType = "default"
+
+// 房间逻辑管理器, 按房间类型创建逻辑实例
+type RoomLogicManager struct {
+	factories map[string]func() RoomLogic
+}
+
+func NewRoomLogicManager() *RoomLogicManager {
+	return &RoomLogicManager{
+		factories: make(map[string]func() RoomLogic),
+	}
+}
+
+// 注册房间类型
+func (m *RoomLogicManager) Register(roomType string, factory func() RoomLogic) {
+	m.factories[roomType] = factory
+}
+
+// 房间类型是否已注册, roomType 为空时检查默认类型
+func (m *RoomLogicManager) Has(roomType string) bool {
+	if roomType == "" {
+		roomType = DefaultRoomType
+	}
+	_, ok := m.factories[roomType]
+	return ok
+}
+
+// 创建房间逻辑, roomType 为空时使用默认类型
+func (m *RoomLogicManager) Create(roomType string) (RoomLogic, bool) {
+	if roomType == "" {
+		roomType = DefaultRoomType
+	}
+	factory, ok := m.factories[roomType]
+	if !ok {
+		log.Printf("Room type %s not registered", roomType)
+		return nil, false
+	}
+	return factory(), true
+}
+
+// 全局房间逻辑管理器实例
+var RoomLogics = NewRoomLogicManager()
+
+// 注册所有房间类型
+func InitRoomLogics() {
+	RoomLogics.Register(DefaultRoomType, func() RoomLogic { return BaseRoomLogic{} })
+}
+
+// 把没有处理器的消息交给房间逻辑, 房间逻辑也不处理时回复 UNKNOWN_MESSAGE
+func (r *Room) HandleRoomMessage(event *Event) {
+	player, ok := r.Players[event.PlayerId]
+	if !ok {
+		return
+	}
+	msg := event.Payload.(*pb.Message)
+	if !r.Logic.OnMessage(r, player, msg) {
+		log.Printf("Player %s sent message %s not handled by room %s", player.Id, msg.GetId(), r.Name)
+		player.SendError(msg, pb.ErrorCode_UNKNOWN_MESSAGE, "")
+	}
+}
@@ -0,0 +1,159 @@
+package main
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	pb "server/src/proto"
+)
+
+// 发送优先级, 发送协程总是先发高优先级的消息
+type SendPriority int
+
+const (
+	PriorityState    SendPriority = iota // 状态通知, 队列满时丢弃最旧的一条
+	PriorityNormal                       // 普通通知, 队列满且没有状态通知可丢时丢弃新消息
+	PriorityResponse                     // 响应和踢出通知, 从不丢弃
+	priorityCount
+)
+
+// 按消息 id 指定的优先级, 未列出的通知为 PriorityNormal
+var messagePriorities = map[pb.MessageId]SendPriority{
+	pb.MessageId_ROOM_STATE_NOTIFICATION:      PriorityState,
+	pb.MessageId_STATE_DELTA_NOTIFICATION:     PriorityState, // 增量基于客户端已确认的快照, 丢失后下一次增量会补上
+	pb.MessageId_KICK_NOTIFICATION:            PriorityResponse,
+	pb.MessageId_SERVER_SHUTDOWN_NOTIFICATION: PriorityResponse,
+}
+
+// 修改消息的发送优先级, 只能在启动时调用
+func SetMessagePriority(msgId pb.MessageId, priority SendPriority) {
+	messagePriorities[msgId] = priority
+}
+
+func priorityOf(msgId pb.MessageId) SendPriority {
+	if priority, ok := messagePriorities[msgId]; ok {
+		return priority
+	}
+	return PriorityNormal
+}
+
+// 发送队列中的一项, msg 由发送协程编码, frame 是已编码好的共享帧, 二者只有一个非空
+type outboundItem struct {
+	msg   *pb.Message
+	frame *Frame
+}
+
+// 发送队列统计, 可在任意协程中读取
+type SendStats struct {
+	DroppedState    uint64 // 被丢弃的状态通知
+	DroppedNormal   uint64 // 被丢弃的普通通知
+	SlowDisconnects uint64 // 因积压被断开的玩家
+}
+
+// 全局发送统计实例
+var OutboundStats = &SendStats{}
+
+// 获取发送统计快照
+func (s *SendStats) Snapshot() SendStats {
+	return SendStats{
+		DroppedState:    atomic.LoadUint64(&s.DroppedState),
+		DroppedNormal:   atomic.LoadUint64(&s.DroppedNormal),
+		SlowDisconnects: atomic.LoadUint64(&s.SlowDisconnects),
+	}
+}
+
+// 玩家的发送队列, 入队不会阻塞, 由发送协程取出
+type SendQueue struct {
+	mu           sync.Mutex
+	queues       [priorityCount][]outboundItem
+	size         int
+	capacity     int // 可丢弃消息的容量上限, 响应不受限制
+	backlog      int // 积压阈值
+	backlogSince time.Time
+	dropped      uint64
+	ready        chan struct{} // 有新消息时写入, 容量为 1
+}
+
+func NewSendQueue(capacity, backlog int) *SendQueue {
+	return &SendQueue{
+		capacity: capacity,
+		backlog:  backlog,
+		ready:    make(chan struct{}, 1),
+	}
+}
+
+// 入队, 返回队列持续超过积压阈值的时间
+func (q *SendQueue) Push(item outboundItem, priority SendPriority) time.Duration {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.size >= q.capacity && priority != PriorityResponse {
+		if state := q.queues[PriorityState]; len(state) > 0 {
+			// 丢弃最旧的状态通知
+			copy(state, state[1:])
+			state[len(state)-1] = outboundItem{}
+			q.queues[PriorityState] = state[:len(state)-1]
+			q.size--
+			q.dropped++
+			atomic.AddUint64(&OutboundStats.DroppedState, 1)
+		} else {
+			q.dropped++
+			atomic.AddUint64(&OutboundStats.DroppedNormal, 1)
+			return q.backlogDuration(time.Now())
+		}
+	}
+
+	q.queues[priority] = append(q.queues[priority], item)
+	q.size++
+	select {
+	case q.ready <- struct{}{}:
+	default:
+	}
+	return q.backlogDuration(time.Now())
+}
+
+func (q *SendQueue) backlogDuration(now time.Time) time.Duration {
+	if q.size < q.backlog {
+		q.backlogSince = time.Time{}
+		return 0
+	}
+	if q.backlogSince.IsZero() {
+		q.backlogSince = now
+	}
+	return now.Sub(q.backlogSince)
+}
+
+// 有消息可取时可读
+func (q *SendQueue) Ready() <-chan struct{} {
+	return q.ready
+}
+
+// 按优先级从高到低取出所有消息追加到 dst
+func (q *SendQueue) PopAll(dst []outboundItem) []outboundItem {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for priority := priorityCount - 1; priority >= 0; priority-- {
+		queue := q.queues[priority]
+		dst = append(dst, queue...)
+		clear(queue)
+		q.queues[priority] = queue[:0]
+	}
+	q.size = 0
+	q.backlogSince = time.Time{}
+	return dst
+}
+
+// 队列中的消息数
+func (q *SendQueue) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.size
+}
+
+// 累计丢弃的消息数
+func (q *SendQueue) Dropped() uint64 {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.dropped
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	pb "server/src/proto"
+)
+
+// 停服结果, 用于最后的汇总日志
+type ShutdownSummary struct {
+	Players       int // 通知到的玩家数
+	Flushed       int // 在截止时间前发完消息的玩家数
+	FlushTimeouts int // 截止时间到时仍有消息未发出的玩家数
+	Rooms         int // 关闭的房间数
+	RoomTimeouts  int // 截止时间到时还没退出的房间数
+	Duration      time.Duration
+}
+
+// 停服: 通知所有玩家, 关闭房间(执行 OnClose), 在截止时间内尽量发完消息后断开连接
+// 调用前应先停止接受新连接
+func Shutdown(reason string) ShutdownSummary {
+	start := time.Now()
+	deadline := start.Add(Config.ShutdownTimeout)
+	log.Printf("Shutting down: %s (timeout %v)", reason, Config.ShutdownTimeout)
+
+	players := GlobalManager.GetAllPlayers()
+	notification := mustEncodeFrame(&pb.Message{
+		Id:          pb.MessageId_SERVER_SHUTDOWN_NOTIFICATION,
+		MsgSerialNo: -1,
+		ClientId:    "",
+		Data: mustMarshal(&pb.ServerShutdownNotification{
+			Reason:           reason,
+			ReconnectAddr:    Config.ReconnectAddr,
+			ReconnectDelayMs: uint32(Config.ReconnectDelay / time.Millisecond),
+		}),
+	})
+	for _, player := range players {
+		player.SendFrame(notification)
+	}
+
+	// 房间关闭时 OnClose 可能还会给玩家发消息, 所以先关房间再等发送队列清空
+	rooms := GlobalManager.GetAllRooms()
+	roomTimeouts := waitAll(len(rooms), deadline, func(i int) {
+		GlobalManager.DeleteRoom(rooms[i].ID)
+		<-rooms[i].Done()
+	})
+
+	var flushed int64
+	waitAll(len(players), deadline, func(i int) {
+		if players[i].Flush(deadline) {
+			atomic.AddInt64(&flushed, 1)
+		}
+	})
+	for _, player := range players {
+		player.Quit()
+	}
+
+	summary := ShutdownSummary{
+		Players:       len(players),
+		Flushed:       int(atomic.LoadInt64(&flushed)),
+		FlushTimeouts: len(players) - int(atomic.LoadInt64(&flushed)),
+		Rooms:         len(rooms) - roomTimeouts,
+		RoomTimeouts:  roomTimeouts,
+		Duration:      time.Since(start),
+	}
+	stats := OutboundStats.Snapshot()
+	log.Printf("Shutdown complete in %v: %d players notified, %d flushed, %d flush timeouts, %d rooms closed, %d room close timeouts, dropped %d state / %d normal messages, %d slow disconnects",
+		summary.Duration, summary.Players, summary.Flushed, summary.FlushTimeouts, summary.Rooms, summary.RoomTimeouts,
+		stats.DroppedState, stats.DroppedNormal, stats.SlowDisconnects)
+	return summary
+}
+
+// 并发执行 n 个任务, 返回截止时间到时还没完成的任务数
+func waitAll(n int, deadline time.Time, task func(i int)) int {
+	var pending int64 = int64(n)
+	var wg sync.WaitGroup
+	wg.Add(n)
+	for i := 0; i < n; i++ {
+		go func(i int) {
+			defer wg.Done()
+			task(i)
+			atomic.AddInt64(&pending, -1)
+		}(i)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Until(deadline)):
+	}
+	return int(atomic.LoadInt64(&pending))
+}
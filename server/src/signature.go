@@ -7,6 +7,7 @@ import (
 	"encoding/base64"
 	"encoding/hex"
 	"net"
+	"strconv"
 	"sync"
 	"sync/atomic"
 )
@@ -18,8 +19,8 @@ var connCounter uint64
 func GenerateConnID(conn net.Conn) string {
 	remoteAddr := conn.RemoteAddr().String() // 获取远程地址 (IP:Port)
 	connID := atomic.AddUint64(&connCounter, 1)
-	hash := md5.Sum([]byte(remoteAddr + string(connID))) // 基于地址和计数生成哈希
-	return hex.EncodeToString(hash[:])                   // 返回字符串格式的哈希值
+	hash := md5.Sum([]byte(remoteAddr + strconv.FormatUint(connID, 10))) // 基于地址和计数生成哈希
+	return hex.EncodeToString(hash[:])                                   // 返回字符串格式的哈希值
 }
 
 // GenerateShortUUID 生成短版 UUID
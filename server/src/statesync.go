@@ -0,0 +1,264 @@
+package main
+
+import pb "server/src/proto"
+
+// 每个客户端保留的已发送快照数量, 客户端确认的快照被淘汰后改发全量快照
+const StateHistorySize = 64
+
+const allPlayerFields = pb.PlayerField_FIELD_NAME | pb.PlayerField_FIELD_POSITION | pb.PlayerField_FIELD_TEAM | pb.PlayerField_FIELD_INPUT_SEQ
+
+type playerSnapshot struct {
+	Name         string
+	TeamId       int32
+	X, Y, Z      float32
+	LastInputSeq uint32
+}
+
+type roomSnapshot struct {
+	seq      uint32
+	players  map[string]playerSnapshot
+	entities map[uint64]uint64 // 实体 id -> 版本号
+}
+
+// 客户端同步状态, 只在房间协程中访问
+type clientSync struct {
+	ackedSeq uint32                          // 客户端最近确认的快照序号, 0 表示需要全量快照
+	sentSeq  uint32                          // 最近发送给客户端的快照序号
+	history  [StateHistorySize]*roomSnapshot // 已发送给该客户端的快照, 开启视野过滤时各不相同
+}
+
+// 广播房间状态, 增量同步房间按客户端发送差量, 否则发送全量 RoomStateNotification
+func (r *Room) BroadcastState(excludePlayerID string) {
+	if r.aoi != nil {
+		r.updateViews()
+	}
+	if r.DeltaSync {
+		r.syncDelta()
+		return
+	}
+
+	if r.aoi == nil {
+		noti := &pb.Message{
+			Id:          pb.MessageId_ROOM_STATE_NOTIFICATION,
+			MsgSerialNo: -1,
+			ClientId:    "",
+			Data: mustMarshal(&pb.RoomStateNotification{
+				Room: r.FillRoomMsg(),
+			}),
+		}
+		r.Broadcast(excludePlayerID, noti)
+		return
+	}
+
+	r.Mutex.Lock()
+	defer r.Mutex.Unlock()
+	for id, player := range r.Players {
+		if id != excludePlayerID {
+			r.sendRoomStateTo(player)
+		}
+	}
+}
+
+// 广播玩家移动, 开启视野过滤时只发给能看到该玩家的人
+func (r *Room) BroadcastMove(playerId string) {
+	if r.aoi == nil || r.DeltaSync {
+		r.BroadcastState(playerId)
+		return
+	}
+
+	r.updateViewsFor(playerId)
+
+	r.Mutex.Lock()
+	defer r.Mutex.Unlock()
+	for id, player := range r.Players {
+		if id != playerId && r.CanSee(id, playerId) {
+			r.sendRoomStateTo(player)
+		}
+	}
+}
+
+// 发送按视野过滤后的房间状态, 调用方需持有 r.Mutex
+func (r *Room) sendRoomStateTo(player *Player) {
+	player.SendMessage(&pb.Message{
+		Id:          pb.MessageId_ROOM_STATE_NOTIFICATION,
+		MsgSerialNo: -1,
+		ClientId:    "",
+		Data: mustMarshal(&pb.RoomStateNotification{
+			Room: r.FillRoomMsgFor(player.Id),
+		}),
+	})
+}
+
+// 生成新快照并向每个客户端发送相对其确认快照的差量
+func (r *Room) syncDelta() {
+	r.Mutex.Lock()
+	defer r.Mutex.Unlock()
+
+	r.stateSeq++
+	current := &roomSnapshot{
+		seq:      r.stateSeq,
+		players:  make(map[string]playerSnapshot, len(r.Players)),
+		entities: make(map[uint64]uint64, len(r.Entities)),
+	}
+	for id, player := range r.Players {
+		snap := playerSnapshot{Name: player.Name, TeamId: player.TeamId, LastInputSeq: player.kinematic.lastInputSeq}
+		if player.Position != nil {
+			snap.X, snap.Y, snap.Z = player.Position.X, player.Position.Y, player.Position.Z
+		}
+		current.players[id] = snap
+	}
+	for id, e := range r.Entities {
+		current.entities[id] = e.version
+	}
+
+	for id, player := range r.Players {
+		cs, ok := r.clientSyncs[id]
+		if !ok {
+			cs = &clientSync{}
+			r.clientSyncs[id] = cs
+		}
+
+		view := current
+		if r.aoi != nil {
+			view = r.filterSnapshot(id, current)
+		}
+		noti := diffSnapshot(cs.snapshotAt(cs.ackedSeq), view, r.Entities)
+		// 与已确认的快照相同时, 只有客户端没有收到更新的差量才能跳过;
+		// 否则状态变回确认快照的过程会丢失, 客户端停留在最近收到的状态
+		if isEmptyDelta(noti) && (cs.sentSeq == cs.ackedSeq ||
+			isEmptyDelta(diffSnapshot(cs.snapshotAt(cs.sentSeq), view, r.Entities))) {
+			continue
+		}
+		cs.history[view.seq%StateHistorySize] = view
+		cs.sentSeq = view.seq
+		player.SendMessage(&pb.Message{
+			Id:          pb.MessageId_STATE_DELTA_NOTIFICATION,
+			MsgSerialNo: -1,
+			ClientId:    "",
+			Data:        mustMarshal(noti),
+		})
+	}
+}
+
+// 差量是否没有任何变化
+func isEmptyDelta(noti *pb.StateDeltaNotification) bool {
+	return !noti.Full && len(noti.Players) == 0 && len(noti.Removed) == 0 &&
+		len(noti.Entities) == 0 && len(noti.RemovedEntities) == 0
+}
+
+// 只保留 viewerId 可见玩家的快照
+func (r *Room) filterSnapshot(viewerId string, snap *roomSnapshot) *roomSnapshot {
+	view := &roomSnapshot{
+		seq:      snap.seq,
+		players:  make(map[string]playerSnapshot),
+		entities: make(map[uint64]uint64),
+	}
+	for id, player := range snap.players {
+		if r.CanSee(viewerId, id) {
+			view.players[id] = player
+		}
+	}
+	for id, version := range snap.entities {
+		if r.CanSeeEntity(viewerId, r.Entities[id]) {
+			view.entities[id] = version
+		}
+	}
+	return view
+}
+
+// 查找已发送的快照, 不存在或已被淘汰时返回 nil
+func (cs *clientSync) snapshotAt(seq uint32) *roomSnapshot {
+	if seq == 0 {
+		return nil
+	}
+	snap := cs.history[seq%StateHistorySize]
+	if snap == nil || snap.seq != seq {
+		return nil
+	}
+	return snap
+}
+
+// 计算 base 到 current 的差量, base 为 nil 时生成全量快照
+// 实体不做字段级差量, 有变化时下发完整实体
+func diffSnapshot(base, current *roomSnapshot, entities map[uint64]*Entity) *pb.StateDeltaNotification {
+	noti := &pb.StateDeltaNotification{Seq: current.seq}
+	if base == nil {
+		noti.Full = true
+	} else {
+		noti.BaseSeq = base.seq
+	}
+
+	for id, cur := range current.players {
+		var fields pb.PlayerField
+		if base == nil {
+			fields = allPlayerFields
+		} else if old, ok := base.players[id]; !ok {
+			fields = allPlayerFields
+		} else {
+			if old.Name != cur.Name {
+				fields |= pb.PlayerField_FIELD_NAME
+			}
+			if old.X != cur.X || old.Y != cur.Y || old.Z != cur.Z {
+				fields |= pb.PlayerField_FIELD_POSITION
+			}
+			if old.TeamId != cur.TeamId {
+				fields |= pb.PlayerField_FIELD_TEAM
+			}
+			if old.LastInputSeq != cur.LastInputSeq {
+				fields |= pb.PlayerField_FIELD_INPUT_SEQ
+			}
+		}
+		if fields == pb.PlayerField_FIELD_NONE {
+			continue
+		}
+
+		delta := &pb.PlayerDelta{Id: id, Fields: uint32(fields)}
+		if fields&pb.PlayerField_FIELD_NAME != 0 {
+			delta.Name = cur.Name
+		}
+		if fields&pb.PlayerField_FIELD_POSITION != 0 {
+			delta.Position = &pb.Position{X: cur.X, Y: cur.Y, Z: cur.Z}
+		}
+		if fields&pb.PlayerField_FIELD_TEAM != 0 {
+			delta.TeamId = cur.TeamId
+		}
+		if fields&pb.PlayerField_FIELD_INPUT_SEQ != 0 {
+			delta.LastInputSeq = cur.LastInputSeq
+		}
+		noti.Players = append(noti.Players, delta)
+	}
+
+	for id, version := range current.entities {
+		if base != nil && base.entities[id] == version {
+			continue
+		}
+		noti.Entities = append(noti.Entities, entities[id].ToProto())
+	}
+
+	if base != nil {
+		for id := range base.players {
+			if _, ok := current.players[id]; !ok {
+				noti.Removed = append(noti.Removed, id)
+			}
+		}
+		for id := range base.entities {
+			if _, ok := current.entities[id]; !ok {
+				noti.RemovedEntities = append(noti.RemovedEntities, id)
+			}
+		}
+	}
+	return noti
+}
+
+// 记录客户端确认的快照序号
+func (r *Room) HandleStateAck(event *Event) {
+	cs, ok := r.clientSyncs[event.PlayerId]
+	if !ok {
+		return
+	}
+	ack := event.Payload.(*pb.StateAck)
+	if ack.Seq > r.stateSeq || ack.Seq <= cs.ackedSeq {
+		return // 乱序或非法的确认
+	}
+	cs.ackedSeq = ack.Seq
+}
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"net"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	pb "server/src/proto"
+)
+
+// 创建一个连接到管道的玩家, 发出的消息留在发送队列中供测试读取
+func newTestPlayer(t *testing.T, id string) *Player {
+	conn, peer := net.Pipe()
+	t.Cleanup(func() {
+		conn.Close()
+		peer.Close()
+	})
+	return NewPlayer(id, conn)
+}
+
+// 取出玩家发送队列中的所有状态差量
+func popDeltas(t *testing.T, player *Player) []*pb.StateDeltaNotification {
+	var deltas []*pb.StateDeltaNotification
+	for _, item := range player.outbox.PopAll(nil) {
+		if item.msg.GetId() != pb.MessageId_STATE_DELTA_NOTIFICATION {
+			continue
+		}
+		noti := &pb.StateDeltaNotification{}
+		if err := proto.Unmarshal(item.msg.GetData(), noti); err != nil {
+			t.Fatal(err)
+		}
+		deltas = append(deltas, noti)
+	}
+	return deltas
+}
+
+func TestDiffSnapshot(t *testing.T) {
+	base := &roomSnapshot{seq: 1, players: map[string]playerSnapshot{
+		"a": {Name: "a", TeamId: 1},
+		"b": {Name: "b", TeamId: 1, X: 1},
+	}}
+
+	tests := []struct {
+		name    string
+		players map[string]playerSnapshot
+		fields  map[string]pb.PlayerField
+		removed []string
+	}{
+		{"unchanged", base.players, nil, nil},
+		{"team", map[string]playerSnapshot{"a": {Name: "a", TeamId: 2}, "b": base.players["b"]},
+			map[string]pb.PlayerField{"a": pb.PlayerField_FIELD_TEAM}, nil},
+		{"position", map[string]playerSnapshot{"a": base.players["a"], "b": {Name: "b", TeamId: 1, X: 2}},
+			map[string]pb.PlayerField{"b": pb.PlayerField_FIELD_POSITION}, nil},
+		{"removed", map[string]playerSnapshot{"a": base.players["a"]}, nil, []string{"b"}},
+		{"added", map[string]playerSnapshot{"a": base.players["a"], "b": base.players["b"], "c": {Name: "c"}},
+			map[string]pb.PlayerField{"c": allPlayerFields}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			noti := diffSnapshot(base, &roomSnapshot{seq: 2, players: tt.players}, nil)
+			if noti.Full || noti.BaseSeq != 1 || noti.Seq != 2 {
+				t.Fatalf("header = full %v base %d seq %d", noti.Full, noti.BaseSeq, noti.Seq)
+			}
+			if len(noti.Players) != len(tt.fields) {
+				t.Fatalf("players = %v, want fields %v", noti.Players, tt.fields)
+			}
+			for _, delta := range noti.Players {
+				if pb.PlayerField(delta.Fields) != tt.fields[delta.Id] {
+					t.Errorf("player %s fields = %v, want %v", delta.Id, delta.Fields, tt.fields[delta.Id])
+				}
+			}
+			if len(noti.Removed) != len(tt.removed) || (len(tt.removed) > 0 && noti.Removed[0] != tt.removed[0]) {
+				t.Errorf("removed = %v, want %v", noti.Removed, tt.removed)
+			}
+		})
+	}
+
+	if noti := diffSnapshot(nil, base, nil); !noti.Full || len(noti.Players) != 2 {
+		t.Errorf("nil base: full %v, %d players", noti.Full, len(noti.Players))
+	}
+}
+
+// 状态在客户端确认新差量之前变回已确认的快照, 必须再发一次差量把客户端拉回来
+func TestSyncDeltaRevertToAcked(t *testing.T) {
+	room := NewRoom(1, "test", RoomConfig{DeltaSync: true, TeamCount: 2})
+	viewer := newTestPlayer(t, "viewer")
+	mover := newTestPlayer(t, "mover")
+	viewer.TeamId, mover.TeamId = 1, 1
+	room.Players[viewer.Id] = viewer
+	room.Players[mover.Id] = mover
+
+	room.syncDelta()
+	deltas := popDeltas(t, viewer)
+	if len(deltas) != 1 || !deltas[0].Full {
+		t.Fatalf("first sync = %v, want one full snapshot", deltas)
+	}
+	acked := deltas[0].Seq
+	room.HandleStateAck(&Event{PlayerId: viewer.Id, Payload: &pb.StateAck{Seq: acked}})
+
+	mover.TeamId = 2
+	room.syncDelta()
+	if deltas = popDeltas(t, viewer); len(deltas) != 1 || len(deltas[0].Players) != 1 {
+		t.Fatalf("team switch = %v, want one player delta", deltas)
+	}
+
+	// 客户端还没有确认上一条差量, 状态已经变回确认时的样子
+	mover.TeamId = 1
+	room.syncDelta()
+	deltas = popDeltas(t, viewer)
+	if len(deltas) != 1 {
+		t.Fatalf("revert sent %d deltas, want 1", len(deltas))
+	}
+	if deltas[0].BaseSeq != acked || len(deltas[0].Players) != 0 {
+		t.Fatalf("revert delta = %v, want empty delta based on %d", deltas[0], acked)
+	}
+
+	// 客户端已经收到与当前状态一致的差量, 之后没有变化时不再发送
+	room.syncDelta()
+	if deltas = popDeltas(t, viewer); len(deltas) != 0 {
+		t.Fatalf("unchanged state sent %v", deltas)
+	}
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"log"
+
+	pb "server/src/proto"
+)
+
+// 队伍编号从 1 开始, 0 表示未分队
+const NoTeam int32 = 0
+
+// 队伍数量和每队人数的上限, 分队时按队伍逐个统计人数, 过大的值会拖住房间协程
+const (
+	MaxTeamCount int32 = 16
+	MaxTeamSize  int32 = 100
+)
+
+// 是否启用了分队
+func (r *Room) HasTeams() bool {
+	return r.TeamCount > 0
+}
+
+// 统计队伍人数, 调用方需持有 r.Mutex
+func (r *Room) teamMemberCount(teamId int32) int32 {
+	var count int32
+	for _, player := range r.Players {
+		if player.TeamId == teamId {
+			count++
+		}
+	}
+	return count
+}
+
+// 选出人数最少且未满的队伍, 全部满员时返回 NoTeam, 调用方需持有 r.Mutex
+func (r *Room) balancedTeam() int32 {
+	best, bestCount := NoTeam, int32(-1)
+	for teamId := int32(1); teamId <= r.TeamCount; teamId++ {
+		count := r.teamMemberCount(teamId)
+		if r.TeamSize > 0 && count >= r.TeamSize {
+			continue
+		}
+		if bestCount < 0 || count < bestCount {
+			best, bestCount = teamId, count
+		}
+	}
+	return best
+}
+
+// 为玩家选择队伍, wanted 为 NoTeam 时自动平衡, 调用方需持有 r.Mutex
+func (r *Room) pickTeam(player *Player, wanted int32) (int32, pb.ErrorCode) {
+	if !r.HasTeams() {
+		if wanted != NoTeam {
+			return NoTeam, pb.ErrorCode_TEAM_NOT_FOUND
+		}
+		return NoTeam, pb.ErrorCode_OK
+	}
+
+	if wanted == NoTeam {
+		teamId := r.balancedTeam()
+		if teamId == NoTeam {
+			return NoTeam, pb.ErrorCode_ROOM_FULL
+		}
+		return teamId, pb.ErrorCode_OK
+	}
+
+	if wanted < 1 || wanted > r.TeamCount {
+		return NoTeam, pb.ErrorCode_TEAM_NOT_FOUND
+	}
+	if player.TeamId != wanted && r.TeamSize > 0 && r.teamMemberCount(wanted) >= r.TeamSize {
+		return NoTeam, pb.ErrorCode_TEAM_FULL
+	}
+	return wanted, pb.ErrorCode_OK
+}
+
+// 切换玩家所在队伍
+func (r *Room) SwitchTeam(player *Player, teamId int32) pb.ErrorCode {
+	r.Mutex.Lock()
+	defer r.Mutex.Unlock()
+
+	if _, ok := r.Players[player.Id]; !ok {
+		return pb.ErrorCode_PLAYER_NOT_IN_ROOM
+	}
+	if !r.HasTeams() || teamId == NoTeam {
+		return pb.ErrorCode_TEAM_NOT_FOUND
+	}
+
+	teamId, ret := r.pickTeam(player, teamId)
+	if ret != pb.ErrorCode_OK {
+		return ret
+	}
+	log.Printf("Player %s switched from team %d to team %d in room %s", player.Name, player.TeamId, teamId, r.Name)
+	player.TeamId = teamId
+	return pb.ErrorCode_OK
+}
+
+// 广播消息给同队玩家（排除发送者）
+func (r *Room) BroadcastToTeam(teamId int32, excludePlayerID string, msg *pb.Message) {
+	frame := mustEncodeFrame(msg)
+
+	r.Mutex.Lock()
+	defer r.Mutex.Unlock()
+	for id, player := range r.Players {
+		if player.TeamId == teamId && id != excludePlayerID {
+			player.SendFrame(frame)
+		}
+	}
+}
+
+func (r *Room) HandleSwitchTeam(event *Event) {
+	player, ok := r.Players[event.PlayerId]
+	if !ok {
+		event.ResponseChan <- &pb.SwitchTeamResponse{Ret: pb.ErrorCode_PLAYER_NOT_IN_ROOM}
+		return
+	}
+
+	req := event.Payload.(*pb.SwitchTeamRequest)
+	ret := r.SwitchTeam(player, req.TeamId)
+	event.ResponseChan <- &pb.SwitchTeamResponse{
+		Ret:    ret,
+		TeamId: player.TeamId,
+	}
+	if ret != pb.ErrorCode_OK {
+		return
+	}
+
+	r.BroadcastState(player.Id)
+}
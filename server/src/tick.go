@@ -0,0 +1,83 @@
+package main
+
+import (
+	"log"
+	"sync/atomic"
+	"time"
+)
+
+// 房间逻辑帧率上限
+const MaxTickRate = 120
+
+// 帧循环统计, 可在其他协程中读取
+type TickStats struct {
+	Ticks        uint64 // 已执行帧数
+	Overruns     uint64 // 处理耗时超过帧间隔的次数
+	LastDuration int64  // 最近一帧耗时(纳秒)
+	MaxDuration  int64  // 最长一帧耗时(纳秒)
+}
+
+// 帧间隔, TickRate 为 0 时返回 0
+func (r *Room) TickInterval() time.Duration {
+	if r.TickRate <= 0 {
+		return 0
+	}
+	return time.Second / time.Duration(r.TickRate)
+}
+
+// 获取帧循环统计快照
+func (r *Room) TickStats() TickStats {
+	return TickStats{
+		Ticks:        atomic.LoadUint64(&r.tickStats.Ticks),
+		Overruns:     atomic.LoadUint64(&r.tickStats.Overruns),
+		LastDuration: atomic.LoadInt64(&r.tickStats.LastDuration),
+		MaxDuration:  atomic.LoadInt64(&r.tickStats.MaxDuration),
+	}
+}
+
+// 执行一帧: 应用累积的输入, 调用逻辑回调, 广播一次房间状态
+func (r *Room) tick(dt time.Duration) {
+	start := time.Now()
+
+	// 每帧都清空累积的移动, 不应用的输入也不能一直留在队列里
+	pending := r.pendingInputs
+	r.pendingInputs = r.pendingInputs[:0]
+
+	// 帧同步房间只收集并转发输入, 不做状态同步
+	if r.IsLockstep() {
+		r.Logic.OnTick(r, dt)
+		r.flushEntityChanges()
+		r.stepFrame()
+		r.recordTick(time.Since(start), dt)
+		return
+	}
+
+	for _, event := range pending {
+		r.applyMove(event)
+	}
+
+	if r.Authoritative {
+		r.simulate(dt)
+	}
+
+	r.Logic.OnTick(r, dt)
+	r.flushEntityChanges()
+
+	if len(r.Players) > 0 {
+		r.BroadcastState("")
+	}
+
+	r.recordTick(time.Since(start), dt)
+}
+
+func (r *Room) recordTick(cost time.Duration, interval time.Duration) {
+	ticks := atomic.AddUint64(&r.tickStats.Ticks, 1)
+	atomic.StoreInt64(&r.tickStats.LastDuration, int64(cost))
+	if int64(cost) > atomic.LoadInt64(&r.tickStats.MaxDuration) {
+		atomic.StoreInt64(&r.tickStats.MaxDuration, int64(cost))
+	}
+	if cost > interval {
+		overruns := atomic.AddUint64(&r.tickStats.Overruns, 1)
+		log.Printf("Room %s tick %d overrun: cost %v > interval %v (overruns: %d)", r.Name, ticks, cost, interval, overruns)
+	}
+}
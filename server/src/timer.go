@@ -0,0 +1,152 @@
+package main
+
+import "time"
+
+// 时间轮参数: 每格 50ms, 512 格覆盖约 25.6s, 更长的定时器按圈数等待
+const (
+	TimerResolution = 50 * time.Millisecond
+	TimerWheelSlots = 512
+)
+
+// 房间定时器, 回调在房间协程中执行, 所有方法也只能在房间协程中调用
+type Timer struct {
+	id         uint64
+	expireTick uint64        // 到期时的时间轮刻度
+	interval   time.Duration // 重复间隔, 0 表示一次性定时器
+	callback   func()
+	cancelled  bool
+	wheel      *TimerWheel
+}
+
+// 取消定时器, 可重复调用
+func (t *Timer) Cancel() {
+	if t.cancelled {
+		return
+	}
+	t.cancelled = true
+	t.wheel.remove()
+}
+
+// 时间轮, 由房间协程驱动
+type TimerWheel struct {
+	slots  [TimerWheelSlots][]*Timer
+	tick   uint64 // 当前刻度
+	count  int    // 未触发且未取消的定时器数量
+	nextId uint64
+	ticker *time.Ticker // 有定时器时才运行
+	last   time.Time    // 上一次推进对应的时间
+}
+
+func NewTimerWheel() *TimerWheel {
+	return &TimerWheel{}
+}
+
+// 驱动时间轮的通道, 没有定时器时为 nil, select 不会触发
+func (w *TimerWheel) C() <-chan time.Time {
+	if w.ticker == nil {
+		return nil
+	}
+	return w.ticker.C
+}
+
+func (w *TimerWheel) schedule(t *Timer, delay time.Duration) {
+	ticks := uint64((delay + TimerResolution - 1) / TimerResolution)
+	if ticks == 0 {
+		ticks = 1
+	}
+	t.expireTick = w.tick + ticks
+	slot := t.expireTick % TimerWheelSlots
+	w.slots[slot] = append(w.slots[slot], t)
+}
+
+func (w *TimerWheel) add(delay, interval time.Duration, callback func()) *Timer {
+	w.nextId++
+	t := &Timer{
+		id:       w.nextId,
+		interval: interval,
+		callback: callback,
+		wheel:    w,
+	}
+	w.schedule(t, delay)
+	w.count++
+	if w.ticker == nil {
+		w.ticker = time.NewTicker(TimerResolution)
+		w.last = time.Now()
+	}
+	return t
+}
+
+func (w *TimerWheel) remove() {
+	w.count--
+	if w.count == 0 && w.ticker != nil {
+		w.ticker.Stop()
+		w.ticker = nil
+	}
+}
+
+// 按实际经过的时间推进, 房间协程繁忙导致 ticker 丢拍时会一次补齐
+// 每个到期的回调通过 call 执行, call 负责恢复回调中的 panic, 一个回调出错不影响其他定时器
+func (w *TimerWheel) AdvanceTo(now time.Time, call func(fn func())) {
+	for w.ticker != nil && now.Sub(w.last) >= TimerResolution {
+		w.last = w.last.Add(TimerResolution)
+		w.advance(call)
+	}
+}
+
+// 推进一格并执行到期的回调
+func (w *TimerWheel) advance(call func(fn func())) {
+	w.tick++
+	slot := w.tick % TimerWheelSlots
+	pending := w.slots[slot]
+	w.slots[slot] = nil
+
+	for _, t := range pending {
+		if t.cancelled {
+			continue
+		}
+		if t.expireTick > w.tick {
+			// 还没转满圈数
+			w.slots[slot] = append(w.slots[slot], t)
+			continue
+		}
+
+		call(t.callback)
+		if t.cancelled {
+			continue
+		}
+		if t.interval > 0 {
+			w.schedule(t, t.interval)
+		} else {
+			t.cancelled = true
+			w.remove()
+		}
+	}
+}
+
+// 取消所有定时器
+func (w *TimerWheel) Stop() {
+	for i := range w.slots {
+		for _, t := range w.slots[i] {
+			t.cancelled = true
+		}
+		w.slots[i] = nil
+	}
+	w.count = 0
+	if w.ticker != nil {
+		w.ticker.Stop()
+		w.ticker = nil
+	}
+}
+
+// 延迟 delay 后在房间协程中执行一次 fn
+func (r *Room) After(delay time.Duration, fn func()) *Timer {
+	return r.timers.add(delay, 0, fn)
+}
+
+// 每隔 interval 在房间协程中执行一次 fn, 直到被取消或房间关闭
+func (r *Room) Every(interval time.Duration, fn func()) *Timer {
+	if interval < TimerResolution {
+		interval = TimerResolution
+	}
+	return r.timers.add(interval, interval, fn)
+}